@@ -1,7 +1,10 @@
 package container
 
+import "fmt"
+
 // AppConfig holds configuration for application containers
 type AppConfig struct {
+	IDPrefix         string            `json:"idPrefix"` // empty means "container"
 	ImageName        string            `json:"imageName"`
 	HealthEndpoint   string            `json:"healthEndpoint"`
 	MetricsEndpoint  string            `json:"metricsEndpoint"`
@@ -30,6 +33,48 @@ type SkeletonConfig struct {
 	Storage   SkeletonStorageConfig  `json:"storage"`
 }
 
+// Validate checks the skeleton configuration for missing and conflicting
+// values, returning ConfigErrors listing every invalid field
+func (c *SkeletonConfig) Validate() error {
+	var errs ConfigErrors
+	if c.ServiceID == "" {
+		errs.Add("serviceId", "is required")
+	}
+
+	seen := make(map[string]int, len(c.Plugins))
+	for i, plugin := range c.Plugins {
+		field := fmt.Sprintf("plugins[%d].name", i)
+		if plugin.Name == "" {
+			errs.Add(field, "is required")
+			continue
+		}
+		if first, ok := seen[plugin.Name]; ok {
+			errs.Add(field, fmt.Sprintf("duplicates plugins[%d].name %q", first, plugin.Name))
+			continue
+		}
+		seen[plugin.Name] = i
+	}
+
+	if c.Storage.URL != "" && c.Storage.Type == "" {
+		errs.Add("storage.type", "is required when storage.url is set")
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// SkeletonConfigInjection selects how the skeleton configuration is passed to
+// the application through its environment
+type SkeletonConfigInjection int
+
+const (
+	// InjectBoth passes the SKELETON_CONFIG JSON and the individual SKELETON_* fields
+	InjectBoth SkeletonConfigInjection = iota
+	// InjectJSONOnly passes only the SKELETON_CONFIG JSON
+	InjectJSONOnly
+	// InjectFieldsOnly passes only the individual SKELETON_* fields
+	InjectFieldsOnly
+)
+
 // SkeletonPluginConfig defines a skeleton plugin configuration
 type SkeletonPluginConfig struct {
 	Name    string                 `json:"name"`
@@ -42,3 +87,53 @@ type SkeletonStorageConfig struct {
 	Type string `json:"type"`
 	URL  string `json:"url"`
 }
+
+// SkeletonContract describes the HTTP port and endpoint paths a skeleton application exposes
+type SkeletonContract struct {
+	HTTPPort         int    `json:"httpPort"`
+	HealthPath       string `json:"healthPath"`
+	ComponentsPath   string `json:"componentsPath"`
+	SystemHealthPath string `json:"systemHealthPath"`
+	MetricsPath      string `json:"metricsPath"`
+	ShutdownPath     string `json:"shutdownPath"`
+	OperationsPath   string `json:"operationsPath"`
+}
+
+// DefaultSkeletonContract returns the contract implemented by standard skeleton applications
+func DefaultSkeletonContract() SkeletonContract {
+	return SkeletonContract{
+		HTTPPort:         8080,
+		HealthPath:       "/health",
+		ComponentsPath:   "/api/components",
+		SystemHealthPath: "/api/system/health",
+		MetricsPath:      "/metrics",
+		ShutdownPath:     "/shutdown",
+		OperationsPath:   "/api/operations",
+	}
+}
+
+// Merge returns a copy of the contract with every non-zero field of override applied
+func (c SkeletonContract) Merge(override SkeletonContract) SkeletonContract {
+	if override.HTTPPort != 0 {
+		c.HTTPPort = override.HTTPPort
+	}
+	if override.HealthPath != "" {
+		c.HealthPath = override.HealthPath
+	}
+	if override.ComponentsPath != "" {
+		c.ComponentsPath = override.ComponentsPath
+	}
+	if override.SystemHealthPath != "" {
+		c.SystemHealthPath = override.SystemHealthPath
+	}
+	if override.MetricsPath != "" {
+		c.MetricsPath = override.MetricsPath
+	}
+	if override.ShutdownPath != "" {
+		c.ShutdownPath = override.ShutdownPath
+	}
+	if override.OperationsPath != "" {
+		c.OperationsPath = override.OperationsPath
+	}
+	return c
+}
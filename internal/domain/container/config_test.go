@@ -0,0 +1,63 @@
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkeletonConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config SkeletonConfig
+		want   ConfigErrors
+	}{
+		{
+			name: "valid",
+			config: SkeletonConfig{
+				ServiceID: "payments",
+				Plugins:   []SkeletonPluginConfig{{Name: "ledger"}, {Name: "audit"}},
+				Storage:   SkeletonStorageConfig{Type: "postgres", URL: "postgres://db"},
+			},
+		},
+		{
+			name:   "missing service ID",
+			config: SkeletonConfig{},
+			want:   ConfigErrors{{Field: "serviceId", Reason: "is required"}},
+		},
+		{
+			name: "missing and duplicate plugin names",
+			config: SkeletonConfig{
+				ServiceID: "payments",
+				Plugins:   []SkeletonPluginConfig{{Name: "ledger"}, {}, {Name: "ledger"}},
+			},
+			want: ConfigErrors{
+				{Field: "plugins[1].name", Reason: "is required"},
+				{Field: "plugins[2].name", Reason: `duplicates plugins[0].name "ledger"`},
+			},
+		},
+		{
+			name: "storage URL without type",
+			config: SkeletonConfig{
+				ServiceID: "payments",
+				Storage:   SkeletonStorageConfig{URL: "postgres://db"},
+			},
+			want: ConfigErrors{{Field: "storage.type", Reason: "is required when storage.url is set"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.want == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs ConfigErrors
+			require.True(t, errors.As(err, &errs))
+			require.Equal(t, tt.want, errs)
+		})
+	}
+}
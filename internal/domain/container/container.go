@@ -2,8 +2,11 @@ package container
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -33,23 +36,187 @@ type Container interface {
 	Exec(ctx context.Context, cmd []string) error
 }
 
+// StopRecord records the stop of a single dependency container
+type StopRecord struct {
+	Name      string    // Container name of the dependency
+	StoppedAt time.Time // When the stop returned
+	Err       error     // Error returned by the stop, if any
+}
+
+// StartupMetrics records how long each phase of an application start took.
+// Phases that were skipped, e.g. creation of an already created container,
+// have a zero duration.
+type StartupMetrics struct {
+	Dependencies time.Duration // Starting and waiting for the dependencies
+	Pull         time.Duration // Creating the container, including pulling or building the image
+	Readiness    time.Duration // Starting the container until it is ready
+	Total        time.Duration // The whole start
+}
+
+// ErrArchMismatch is matched, using errors.Is, by errors of containers whose
+// image was built for a different CPU architecture than the host's and could
+// not be run, e.g. an amd64-only image on arm64 without emulation
+var ErrArchMismatch = errors.New("image architecture does not match the host")
+
+// ConfigError describes a single invalid configuration field
+type ConfigError struct {
+	Field  string // Path of the field, e.g. "plugins[1].name"
+	Reason string // Why the value is invalid
+}
+
+// Error implements the error interface
+func (e ConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+// ConfigErrors aggregates every problem found while validating a
+// configuration, so that callers can inspect each invalid field
+type ConfigErrors []ConfigError
+
+// Add records a problem with the given field
+func (e *ConfigErrors) Add(field, reason string) {
+	*e = append(*e, ConfigError{Field: field, Reason: reason})
+}
+
+// ErrorOrNil returns the configuration errors if any were recorded, nil otherwise
+func (e ConfigErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error implements the error interface
+func (e ConfigErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid configuration: %s", strings.Join(messages, "; "))
+}
+
 // ContainerError represents a container-related error
 type ContainerError struct {
 	Operation string
 	Container string
 	Message   string
 	Cause     error
+	// Context holds test annotations of the container, such as a ticket
+	// number or scenario description, to ease triage of failures
+	Context map[string]string
 }
 
 // Error implements the error interface
 func (e *ContainerError) Error() string {
+	var msg string
 	if e.Cause != nil {
-		return fmt.Sprintf("container %s %s failed: %s: %v", e.Container, e.Operation, e.Message, e.Cause)
+		msg = fmt.Sprintf("container %s %s failed: %s: %v", e.Container, e.Operation, e.Message, e.Cause)
+	} else {
+		msg = fmt.Sprintf("container %s %s failed: %s", e.Container, e.Operation, e.Message)
 	}
-	return fmt.Sprintf("container %s %s failed: %s", e.Container, e.Operation, e.Message)
+
+	if len(e.Context) > 0 {
+		keys := make([]string, 0, len(e.Context))
+		for key := range e.Context {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, len(keys))
+		for i, key := range keys {
+			pairs[i] = fmt.Sprintf("%s=%s", key, e.Context[key])
+		}
+		msg = fmt.Sprintf("%s [%s]", msg, strings.Join(pairs, ", "))
+	}
+
+	return msg
 }
 
 // Unwrap returns the underlying error
 func (e *ContainerError) Unwrap() error {
 	return e.Cause
 }
+
+// AnnotateError adds the given annotations to the context of every container
+// error contained in err, keeping context values that are already set
+func AnnotateError(err error, annotations map[string]string) error {
+	if err == nil || len(annotations) == 0 {
+		return err
+	}
+
+	var containerErrs []*ContainerError
+	var multi *MultiError
+	if errors.As(err, &multi) {
+		containerErrs = append(containerErrs, multi.Errors()...)
+	}
+	var containerErr *ContainerError
+	if errors.As(err, &containerErr) {
+		containerErrs = append(containerErrs, containerErr)
+	}
+
+	for _, e := range containerErrs {
+		if e.Context == nil {
+			e.Context = make(map[string]string, len(annotations))
+		}
+		for key, value := range annotations {
+			if _, ok := e.Context[key]; !ok {
+				e.Context[key] = value
+			}
+		}
+	}
+
+	return err
+}
+
+// MultiError aggregates the container errors of an operation spanning several
+// containers, so that no individual failure is lost
+type MultiError struct {
+	errors []*ContainerError
+}
+
+// NewMultiError creates a new, empty multi-container error
+func NewMultiError() *MultiError {
+	return &MultiError{
+		errors: make([]*ContainerError, 0),
+	}
+}
+
+// Add records a container error
+func (m *MultiError) Add(err *ContainerError) {
+	m.errors = append(m.errors, err)
+}
+
+// Errors returns every recorded container error
+func (m *MultiError) Errors() []*ContainerError {
+	return m.errors
+}
+
+// ErrorOrNil returns the multi-container error if any errors were recorded, nil otherwise
+func (m *MultiError) ErrorOrNil() error {
+	if len(m.errors) == 0 {
+		return nil
+	}
+	return m
+}
+
+// Error implements the error interface
+func (m *MultiError) Error() string {
+	if len(m.errors) == 1 {
+		return m.errors[0].Error()
+	}
+
+	messages := make([]string, len(m.errors))
+	for i, err := range m.errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d container operations failed: %s", len(m.errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the recorded errors for use with errors.Is and errors.As
+func (m *MultiError) Unwrap() []error {
+	errs := make([]error, len(m.errors))
+	for i, err := range m.errors {
+		errs[i] = err
+	}
+	return errs
+}
@@ -0,0 +1,191 @@
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		errs    ConfigErrors
+		wantNil bool
+		wantMsg string
+	}{
+		{
+			name:    "empty",
+			wantNil: true,
+		},
+		{
+			name:    "single field",
+			errs:    ConfigErrors{{Field: "serviceId", Reason: "is required"}},
+			wantMsg: "invalid configuration: serviceId: is required",
+		},
+		{
+			name: "every field is listed",
+			errs: ConfigErrors{
+				{Field: "serviceId", Reason: "is required"},
+				{Field: "plugins[1].name", Reason: "is required"},
+			},
+			wantMsg: "invalid configuration: serviceId: is required; plugins[1].name: is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.errs.ErrorOrNil()
+			if tt.wantNil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantMsg)
+		})
+	}
+}
+
+func TestConfigErrorsAdd(t *testing.T) {
+	var errs ConfigErrors
+	errs.Add("storage.type", "is required when storage.url is set")
+
+	require.Equal(t, ConfigErrors{{Field: "storage.type", Reason: "is required when storage.url is set"}}, errs)
+}
+
+func TestConfigErrorsAs(t *testing.T) {
+	cause := ConfigErrors{{Field: "volumes[0].source", Reason: "must be absolute"}}
+	err := &ContainerError{Operation: "create", Container: "app", Message: "invalid configuration", Cause: cause}
+
+	var errs ConfigErrors
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, cause, errs)
+}
+
+func TestContainerErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ContainerError
+		want string
+	}{
+		{
+			name: "without cause",
+			err:  &ContainerError{Operation: "start", Container: "app", Message: "container not initialized"},
+			want: "container app start failed: container not initialized",
+		},
+		{
+			name: "with cause",
+			err:  &ContainerError{Operation: "stop", Container: "db", Message: "failed to stop container", Cause: errors.New("timeout")},
+			want: "container db stop failed: failed to stop container: timeout",
+		},
+		{
+			name: "context sorted by key",
+			err: &ContainerError{
+				Operation: "start",
+				Container: "app",
+				Message:   "failed",
+				Context:   map[string]string{"ticket": "PAY-1", "scenario": "refund"},
+			},
+			want: "container app start failed: failed [scenario=refund, ticket=PAY-1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualError(t, tt.err, tt.want)
+		})
+	}
+}
+
+func TestMultiError(t *testing.T) {
+	first := &ContainerError{Operation: "stop", Container: "db", Message: "failed"}
+	second := &ContainerError{Operation: "stop", Container: "cache", Message: "failed"}
+
+	tests := []struct {
+		name    string
+		errs    []*ContainerError
+		wantNil bool
+		wantMsg string
+	}{
+		{
+			name:    "no errors",
+			wantNil: true,
+		},
+		{
+			name:    "single error keeps its message",
+			errs:    []*ContainerError{first},
+			wantMsg: first.Error(),
+		},
+		{
+			name:    "several errors are counted and joined",
+			errs:    []*ContainerError{first, second},
+			wantMsg: "2 container operations failed: " + first.Error() + "; " + second.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multi := NewMultiError()
+			for _, err := range tt.errs {
+				multi.Add(err)
+			}
+
+			err := multi.ErrorOrNil()
+			if tt.wantNil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantMsg)
+			for _, want := range tt.errs {
+				require.ErrorIs(t, err, want)
+			}
+		})
+	}
+}
+
+func TestMultiErrorUnwrapsCauses(t *testing.T) {
+	errTimeout := errors.New("timeout")
+	multi := NewMultiError()
+	multi.Add(&ContainerError{Operation: "start", Container: "app", Message: "failed", Cause: errTimeout})
+
+	require.ErrorIs(t, multi, errTimeout)
+}
+
+func TestAnnotateError(t *testing.T) {
+	annotations := map[string]string{"ticket": "PAY-1", "scenario": "refund"}
+
+	t.Run("nil error", func(t *testing.T) {
+		require.NoError(t, AnnotateError(nil, annotations))
+	})
+
+	t.Run("container error", func(t *testing.T) {
+		err := &ContainerError{Operation: "start", Container: "app", Message: "failed"}
+
+		require.Same(t, err, AnnotateError(err, annotations))
+		require.Equal(t, annotations, err.Context)
+	})
+
+	t.Run("existing values are kept", func(t *testing.T) {
+		err := &ContainerError{Operation: "start", Container: "app", Message: "failed",
+			Context: map[string]string{"ticket": "PAY-2"}}
+
+		AnnotateError(err, annotations)
+		require.Equal(t, map[string]string{"ticket": "PAY-2", "scenario": "refund"}, err.Context)
+	})
+
+	t.Run("every error of a multi error", func(t *testing.T) {
+		first := &ContainerError{Operation: "stop", Container: "db", Message: "failed"}
+		second := &ContainerError{Operation: "stop", Container: "cache", Message: "failed"}
+		multi := NewMultiError()
+		multi.Add(first)
+		multi.Add(second)
+
+		AnnotateError(multi, annotations)
+		require.Equal(t, annotations, first.Context)
+		require.Equal(t, annotations, second.Context)
+	})
+
+	t.Run("other errors are returned unchanged", func(t *testing.T) {
+		err := errors.New("plain")
+		require.Same(t, err, AnnotateError(err, annotations))
+	})
+}
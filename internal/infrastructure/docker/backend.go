@@ -0,0 +1,279 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
+)
+
+// BackendContainer is a container created by a ContainerBackend, providing
+// its lifecycle, state, port, logs and exec operations. Start must run the
+// wait strategy of the request the container was created for.
+// testcontainers.Container implements it.
+type BackendContainer interface {
+	GetContainerID() string
+
+	// Lifecycle
+	Start(ctx context.Context) error
+	Stop(ctx context.Context, timeout *time.Duration) error
+	Terminate(ctx context.Context) error
+	State(ctx context.Context) (*types.ContainerState, error)
+
+	// Networking
+	Host(ctx context.Context) (string, error)
+	MappedPort(ctx context.Context, port nat.Port) (nat.Port, error)
+	Ports(ctx context.Context) (nat.PortMap, error)
+
+	// Logs
+	Logs(ctx context.Context) (io.ReadCloser, error)
+	FollowOutput(consumer testcontainers.LogConsumer)
+	StartLogProducer(ctx context.Context) error
+	StopLogProducer() error
+
+	// Exec and files
+	Exec(ctx context.Context, cmd []string, options ...tcexec.ProcessOption) (int, io.Reader, error)
+	CopyToContainer(ctx context.Context, content []byte, containerFilePath string, fileMode int64) error
+}
+
+// LogOptions selects the output returned by ContainerBackend.ContainerLogs
+type LogOptions struct {
+	// Since only returns output written at or after this time; zero returns
+	// all output
+	Since time.Time
+	// Follow keeps streaming new output until the context ends
+	Follow bool
+}
+
+// ImageInfo describes an image known to the container runtime
+type ImageInfo struct {
+	ID           string
+	RepoDigests  []string
+	Architecture string   // As reported by the runtime, e.g. x86_64 or amd64
+	Volumes      []string // Container paths declared as volumes by the image
+}
+
+// RuntimeInfo describes the container runtime
+type RuntimeInfo struct {
+	Architecture string   // Host architecture as reported by the runtime
+	Runtimes     []string // Names of the installed OCI runtimes, e.g. "runc"
+}
+
+// ContainerBackend runs containers on a container runtime. Besides creating
+// containers, whose lifecycle is then driven through BackendContainer, it
+// provides the runtime operations the testkit performs by container ID or
+// image reference, so that every runtime call goes through the selected
+// backend.
+type ContainerBackend interface {
+	// Name returns a human-readable name for the backend
+	Name() string
+
+	// CreateContainer creates, but does not start, a container for the request
+	CreateContainer(ctx context.Context, req testcontainers.ContainerRequest) (BackendContainer, error)
+
+	// RemoveContainer force-removes a container, optionally along with its
+	// anonymous volumes
+	RemoveContainer(ctx context.Context, containerID string, removeVolumes bool) error
+
+	// ListContainers returns the IDs of all containers, running or not,
+	// carrying the label, given as "key" or "key=value"
+	ListContainers(ctx context.Context, label string) ([]string, error)
+
+	// ContainerLogs returns the multiplexed stdout and stderr stream of a
+	// container, see stdcopy.StdCopy
+	ContainerLogs(ctx context.Context, containerID string, opts LogOptions) (io.ReadCloser, error)
+
+	// CommitContainer commits the filesystem of a container to a new image
+	// tagged with the reference and returns the new image ID
+	CommitContainer(ctx context.Context, containerID, imageRef string) (string, error)
+
+	// ContainerImage describes the image a container runs
+	ContainerImage(ctx context.Context, containerID string) (ImageInfo, error)
+
+	// InspectImage describes a locally available image
+	InspectImage(ctx context.Context, imageRef string) (ImageInfo, error)
+
+	// ResolveImage checks, without pulling it, that an image can be resolved
+	// in its registry
+	ResolveImage(ctx context.Context, imageRef string) error
+
+	// PullImage pulls an image from its registry, for the platform if not
+	// empty, e.g. "linux/arm64"
+	PullImage(ctx context.Context, imageRef, platform string) error
+
+	// LoadImage loads an image archive produced by `docker save` and returns
+	// the reference of the loaded image
+	LoadImage(ctx context.Context, archive io.Reader) (string, error)
+
+	// PruneVolumes removes the unused volumes carrying the label, given as
+	// "key" or "key=value", and returns their names
+	PruneVolumes(ctx context.Context, label string) ([]string, error)
+
+	// Info describes the container runtime
+	Info(ctx context.Context) (RuntimeInfo, error)
+}
+
+var (
+	backendMutex   sync.RWMutex
+	currentBackend = NewDockerBackend()
+)
+
+// SetBackend sets the backend used to create all subsequently started containers
+func SetBackend(backend ContainerBackend) {
+	backendMutex.Lock()
+	defer backendMutex.Unlock()
+
+	currentBackend = backend
+}
+
+// Backend returns the backend used to create containers
+func Backend() ContainerBackend {
+	backendMutex.RLock()
+	defer backendMutex.RUnlock()
+
+	return currentBackend
+}
+
+const (
+	// ManagedLabel is the label set on every container created by the testkit
+	ManagedLabel = "org.fintechain.skeleton-testkit"
+	// PrefixLabel is the label carrying the container name prefix, if any
+	PrefixLabel = "org.fintechain.skeleton-testkit.prefix"
+	// SessionLabel is the label carrying the ID of the process that created
+	// the container, see SessionID
+	SessionLabel = "org.fintechain.skeleton-testkit.session"
+)
+
+var (
+	namePrefixMutex sync.RWMutex
+	namePrefix      string
+)
+
+// SetContainerNamePrefix sets a prefix prepended to the name of every
+// subsequently created container and recorded in PrefixLabel, so that
+// containers of concurrent pipelines can be told apart. An empty prefix
+// disables prefixing.
+func SetContainerNamePrefix(prefix string) {
+	namePrefixMutex.Lock()
+	defer namePrefixMutex.Unlock()
+
+	namePrefix = prefix
+}
+
+// ContainerNamePrefix returns the container name prefix
+func ContainerNamePrefix() string {
+	namePrefixMutex.RLock()
+	defer namePrefixMutex.RUnlock()
+
+	return namePrefix
+}
+
+// PrefixedName returns the name with the container name prefix prepended,
+// unless it already carries it
+func PrefixedName(name string) string {
+	prefix := ContainerNamePrefix()
+	if prefix == "" || name == "" || strings.HasPrefix(name, prefix+"-") {
+		return name
+	}
+	return prefix + "-" + name
+}
+
+// ManagedRequest returns the request labeled with ManagedLabel and
+// SessionLabel, with the name carrying the container name prefix, as
+// CreateContainer submits it to the backend
+func ManagedRequest(req testcontainers.ContainerRequest) testcontainers.ContainerRequest {
+	labels := make(map[string]string, len(req.Labels)+2)
+	for k, v := range req.Labels {
+		labels[k] = v
+	}
+	labels[ManagedLabel] = "true"
+	labels[SessionLabel] = SessionID()
+	if prefix := ContainerNamePrefix(); prefix != "" {
+		labels[PrefixLabel] = prefix
+	}
+	req.Labels = labels
+	req.Name = PrefixedName(req.Name)
+	return req
+}
+
+// labelVolumes extends a host config modifier to mount an anonymous volume
+// labeled with ManagedLabel and SessionLabel at every volume path of the
+// image the container does not already mount. Docker would otherwise create
+// unlabeled volumes at these paths, which PruneVolumes could not find once
+// their container is removed.
+func labelVolumes(volumes []string, modifier func(*dockercontainer.HostConfig)) func(*dockercontainer.HostConfig) {
+	return func(hostConfig *dockercontainer.HostConfig) {
+		if modifier != nil {
+			modifier(hostConfig)
+		}
+
+		for _, target := range volumes {
+			if mountsTarget(hostConfig, target) {
+				continue
+			}
+			hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+				Type:   mount.TypeVolume,
+				Target: target,
+				VolumeOptions: &mount.VolumeOptions{
+					Labels: map[string]string{
+						ManagedLabel: "true",
+						SessionLabel: SessionID(),
+					},
+				},
+			})
+		}
+	}
+}
+
+// mountsTarget reports whether the host config already mounts something at
+// the container path
+func mountsTarget(hostConfig *dockercontainer.HostConfig, target string) bool {
+	for _, m := range hostConfig.Mounts {
+		if path.Clean(m.Target) == path.Clean(target) {
+			return true
+		}
+	}
+	for _, bind := range hostConfig.Binds {
+		parts := strings.Split(bind, ":")
+		if len(parts) >= 2 && path.Clean(parts[1]) == path.Clean(target) {
+			return true
+		}
+	}
+	_, ok := hostConfig.Tmpfs[target]
+	return ok
+}
+
+// CreateContainer creates, but does not start, a container for the request
+// using the current backend. The request is completed by ManagedRequest, and
+// creation counts towards the SetMaxConcurrentStarts limit. The image is
+// pulled if needed and inspected first, so that the volumes it declares are
+// labeled for PruneVolumes; images built from a Dockerfile have no reference
+// yet, so their volumes are not labeled.
+func CreateContainer(ctx context.Context, req testcontainers.ContainerRequest) (BackendContainer, error) {
+	req = ManagedRequest(req)
+
+	release, err := acquireStartSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if req.Image != "" {
+		volumes, err := imageVolumes(ctx, req.Image, req.ImagePlatform)
+		if err != nil {
+			return nil, err
+		}
+		req.HostConfigModifier = labelVolumes(volumes, req.HostConfigModifier)
+	}
+
+	return Backend().CreateContainer(ctx, req)
+}
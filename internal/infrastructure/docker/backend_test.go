@@ -0,0 +1,80 @@
+package docker
+
+import (
+	"testing"
+
+	dockercontainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelVolumes(t *testing.T) {
+	labels := map[string]string{ManagedLabel: "true", SessionLabel: SessionID()}
+
+	tests := []struct {
+		name       string
+		volumes    []string
+		hostConfig dockercontainer.HostConfig
+		want       []string
+	}{
+		{
+			name: "no image volumes",
+		},
+		{
+			name:    "unmounted volumes are labeled",
+			volumes: []string{"/var/lib/postgresql/data", "/var/log"},
+			want:    []string{"/var/lib/postgresql/data", "/var/log"},
+		},
+		{
+			name:       "bind mounted path",
+			volumes:    []string{"/data", "/var/log"},
+			hostConfig: dockercontainer.HostConfig{Binds: []string{"/tmp/data:/data:ro"}},
+			want:       []string{"/var/log"},
+		},
+		{
+			name:       "mounted path",
+			volumes:    []string{"/data/", "/var/log"},
+			hostConfig: dockercontainer.HostConfig{Mounts: []mount.Mount{{Type: mount.TypeVolume, Target: "/data"}}},
+			want:       []string{"/var/log"},
+		},
+		{
+			name:       "tmpfs path",
+			volumes:    []string{"/data", "/var/log"},
+			hostConfig: dockercontainer.HostConfig{Tmpfs: map[string]string{"/var/log": ""}},
+			want:       []string{"/data"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostConfig := tt.hostConfig
+			existing := len(hostConfig.Mounts)
+
+			labelVolumes(tt.volumes, nil)(&hostConfig)
+
+			added := hostConfig.Mounts[existing:]
+			targets := make([]string, len(added))
+			for i, m := range added {
+				require.Equal(t, mount.TypeVolume, m.Type)
+				require.Equal(t, labels, m.VolumeOptions.Labels)
+				targets[i] = m.Target
+			}
+			if tt.want == nil {
+				tt.want = []string{}
+			}
+			require.Equal(t, tt.want, targets)
+		})
+	}
+}
+
+func TestLabelVolumesAppliesModifierFirst(t *testing.T) {
+	modifier := func(hostConfig *dockercontainer.HostConfig) {
+		hostConfig.Binds = append(hostConfig.Binds, "/tmp/data:/data")
+	}
+
+	var hostConfig dockercontainer.HostConfig
+	labelVolumes([]string{"/data"}, modifier)(&hostConfig)
+
+	require.Equal(t, []string{"/tmp/data:/data"}, hostConfig.Binds)
+	require.Empty(t, hostConfig.Mounts)
+}
@@ -0,0 +1,127 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/fintechain/skeleton-testkit/internal/domain/container"
+)
+
+// DefaultFaketimeLibrary is the path of libfaketime in amd64 Debian and Ubuntu
+// based images with the faketime package installed
+const DefaultFaketimeLibrary = "/usr/lib/x86_64-linux-gnu/faketime/libfaketime.so.1"
+
+// faketimeFile is the in-container file holding the clock offset read by libfaketime
+const faketimeFile = "/tmp/skeleton-testkit-faketime"
+
+// multiarchDirs maps architectures to their Debian multiarch library directory
+var multiarchDirs = map[string]string{
+	"amd64":   "x86_64-linux-gnu",
+	"arm64":   "aarch64-linux-gnu",
+	"arm":     "arm-linux-gnueabihf",
+	"386":     "i386-linux-gnu",
+	"ppc64le": "powerpc64le-linux-gnu",
+	"s390x":   "s390x-linux-gnu",
+}
+
+// FaketimeLibrary returns the path of libfaketime in Debian and Ubuntu based
+// images of the architecture, e.g. "arm64" or "aarch64", with the faketime
+// package installed. Unknown architectures get DefaultFaketimeLibrary.
+func FaketimeLibrary(arch string) string {
+	dir, ok := multiarchDirs[normalizeArch(arch)]
+	if !ok {
+		return DefaultFaketimeLibrary
+	}
+	return "/usr/lib/" + dir + "/faketime/libfaketime.so.1"
+}
+
+// FakeClock configures the time perceived by the container processes through
+// libfaketime, which must be installed in the image
+type FakeClock struct {
+	// Start is the time the container perceives when it starts
+	Start time.Time
+}
+
+// SetFakeClock sets the fake clock of the container
+func (c *ContainerConfig) SetFakeClock(clock FakeClock) {
+	c.FakeClock = &clock
+}
+
+// FakeClockEnvironment returns the environment variables loading libfaketime
+// into every container process, or nil if the container has no fake clock.
+// Without FaketimeLibrary, the library path is derived from the architecture
+// of Platform, or of the test host if no platform is selected, which does not
+// match images of another architecture run on a remote or emulating daemon.
+func (c *ContainerConfig) FakeClockEnvironment() map[string]string {
+	if c.FakeClock == nil {
+		return nil
+	}
+
+	library := c.FaketimeLibrary
+	if library == "" {
+		arch := runtime.GOARCH
+		if parts := strings.Split(c.Platform, "/"); len(parts) >= 2 {
+			arch = parts[1]
+		}
+		library = FaketimeLibrary(arch)
+	}
+
+	return map[string]string{
+		"LD_PRELOAD":              library,
+		"FAKETIME_TIMESTAMP_FILE": faketimeFile,
+		// Re-read the offset on every call so that AdvanceClock takes effect immediately
+		"FAKETIME_NO_CACHE": "1",
+	}
+}
+
+// AdvanceClock moves the container's fake clock forward by the given duration
+func (d *DockerContainer) AdvanceClock(ctx context.Context, by time.Duration) error {
+	if d.config.FakeClock == nil {
+		return &container.ContainerError{
+			Operation: "advance_clock",
+			Container: d.ID(),
+			Message:   "container has no fake clock",
+		}
+	}
+	if d.container == nil {
+		return &container.ContainerError{
+			Operation: "advance_clock",
+			Container: d.ID(),
+			Message:   "container not initialized",
+		}
+	}
+
+	return d.writeClockOffset(ctx, d.clockOffset+by)
+}
+
+// initClock writes the initial clock offset before the container first starts
+func (d *DockerContainer) initClock(ctx context.Context) error {
+	if d.config.FakeClock == nil || d.clockInitialized {
+		return nil
+	}
+
+	if err := d.writeClockOffset(ctx, time.Until(d.config.FakeClock.Start)); err != nil {
+		return err
+	}
+	d.clockInitialized = true
+	return nil
+}
+
+// writeClockOffset writes the offset from real time read by libfaketime
+func (d *DockerContainer) writeClockOffset(ctx context.Context, offset time.Duration) error {
+	content := fmt.Sprintf("%+d", int64(offset/time.Second))
+	if err := d.container.CopyToContainer(ctx, []byte(content), faketimeFile, 0o644); err != nil {
+		return &container.ContainerError{
+			Operation: "advance_clock",
+			Container: d.ID(),
+			Message:   "failed to write fake clock offset",
+			Cause:     err,
+		}
+	}
+
+	d.clockOffset = offset
+	return nil
+}
@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaketimeLibrary(t *testing.T) {
+	tests := []struct {
+		arch string
+		want string
+	}{
+		{"amd64", "/usr/lib/x86_64-linux-gnu/faketime/libfaketime.so.1"},
+		{"x86_64", "/usr/lib/x86_64-linux-gnu/faketime/libfaketime.so.1"},
+		{"arm64", "/usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1"},
+		{"aarch64", "/usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1"},
+		{"armv7l", "/usr/lib/arm-linux-gnueabihf/faketime/libfaketime.so.1"},
+		{"riscv64", DefaultFaketimeLibrary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arch, func(t *testing.T) {
+			require.Equal(t, tt.want, FaketimeLibrary(tt.arch))
+		})
+	}
+}
+
+func TestFakeClockEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		platform    string
+		library     string
+		wantLibrary string
+	}{
+		{"host architecture", "", "", FaketimeLibrary(runtime.GOARCH)},
+		{"platform architecture", "linux/arm64", "", "/usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1"},
+		{"platform variant", "linux/arm/v7", "", "/usr/lib/arm-linux-gnueabihf/faketime/libfaketime.so.1"},
+		{"explicit library", "linux/arm64", "/opt/faketime/libfaketime.so.1", "/opt/faketime/libfaketime.so.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &ContainerConfig{Platform: tt.platform, FaketimeLibrary: tt.library}
+			config.SetFakeClock(FakeClock{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+			require.Equal(t, map[string]string{
+				"LD_PRELOAD":              tt.wantLibrary,
+				"FAKETIME_TIMESTAMP_FILE": faketimeFile,
+				"FAKETIME_NO_CACHE":       "1",
+			}, config.FakeClockEnvironment())
+		})
+	}
+}
+
+func TestFakeClockEnvironmentWithoutClock(t *testing.T) {
+	config := &ContainerConfig{FaketimeLibrary: "/opt/faketime/libfaketime.so.1"}
+
+	require.Nil(t, config.FakeClockEnvironment())
+}
@@ -1,21 +1,105 @@
 package docker
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	dockercontainer "github.com/docker/docker/api/types/container"
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/testcontainers/testcontainers-go"
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
+	"github.com/testcontainers/testcontainers-go/wait"
 
 	"github.com/fintechain/skeleton-testkit/internal/domain/container"
 )
 
-// DockerContainer wraps testcontainers.Container with additional configuration
+// DockerContainer wraps a BackendContainer with additional configuration
 type DockerContainer struct {
-	container testcontainers.Container
-	config    *ContainerConfig
+	container     BackendContainer
+	config        *ContainerConfig
+	logConsumers  []LogConsumer
+	logFanOut     *logFanOut
+	followingLogs bool
+	// starts counts the successful starts of the backend container, which
+	// keeps its output across restarts
+	starts int
+	// clockOffset is the offset of the fake clock from real time
+	clockOffset      time.Duration
+	clockInitialized bool
+	// stateMutex guards the running state cached by IsRunning
+	stateMutex    sync.Mutex
+	cachedRunning bool
+	stateCachedAt time.Time
+}
+
+// LogConsumer receives a single container log line along with the stream
+// ("STDOUT" or "STDERR") it was written to
+type LogConsumer func(stream, line string)
+
+// logFanOut forwards the log output of a backend container to its log
+// consumers. It is registered with the container once, so that consumers do not
+// receive lines twice after a restart. The log producer replays the whole
+// output of the container when restarted, so the frames already forwarded are
+// skipped.
+type logFanOut struct {
+	mutex     sync.Mutex
+	consumers []LogConsumer
+	// received counts the frames received since the log producer started
+	received int
+	// forwarded counts the frames forwarded to the consumers
+	forwarded int
+}
+
+// newLogFanOut creates a fan-out to the given consumers
+func newLogFanOut(consumers []LogConsumer) *logFanOut {
+	return &logFanOut{
+		consumers: append([]LogConsumer(nil), consumers...),
+	}
+}
+
+// add registers another consumer, which receives the frames logged from now on
+func (f *logFanOut) add(consumer LogConsumer) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.consumers = append(f.consumers, consumer)
+}
+
+// rewind prepares for the log producer replaying the output from its start
+func (f *logFanOut) rewind() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.received = 0
+}
+
+// Accept splits a log frame not yet forwarded into lines and forwards each to
+// the consumers
+func (f *logFanOut) Accept(log testcontainers.Log) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.received++
+	if f.received <= f.forwarded {
+		return
+	}
+	f.forwarded++
+
+	for _, line := range strings.Split(strings.TrimRight(string(log.Content), "\r\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		for _, consumer := range f.consumers {
+			consumer(log.LogType, line)
+		}
+	}
 }
 
 // ContainerConfig holds basic container configuration
@@ -23,20 +107,311 @@ type ContainerConfig struct {
 	ID          string
 	Name        string
 	Image       string
+	Kind        string // e.g. "postgres", reported by ManagedContainers; empty means "container"
 	Environment map[string]string
 	Ports       []container.PortMapping
+	CapAdd      []string
+	CapDrop     []string
+	DNS         []string
+	DNSSearch   []string
+	Devices     []DeviceRequest
+	Ulimits     []Ulimit
+	// LogDriver is the Docker log driver, e.g. "local" or "none", configured
+	// with LogDriverOptions; empty uses the daemon default. The "none" driver
+	// disables Logs and FollowLogs.
+	LogDriver        string
+	LogDriverOptions map[string]string
+	// DockerSocket bind-mounts the host Docker socket into the container at
+	// DockerSocketPath, read-only unless DockerSocketWritable is set
+	DockerSocket         bool
+	DockerSocketWritable bool
+	// Privileged runs the container with all capabilities and host devices
+	Privileged bool
+	// ReadOnlyRootFS mounts the root filesystem read-only; TmpfsPaths are
+	// mounted as writable tmpfs
+	ReadOnlyRootFS bool
+	TmpfsPaths     []string
+	// InitScripts are host paths of scripts run by the image's entrypoint on
+	// first start, copied into InitScriptDir; images without an init
+	// directory leave InitScriptDir empty
+	InitScripts   []string
+	InitScriptDir string
+	// Platform selects the image variant to pull, e.g. "linux/amd64"; empty
+	// uses the daemon's platform
+	Platform string
+	// ImageDigest is the digest the container image is pinned to, if any
+	ImageDigest string
+	// Build builds the image from a Dockerfile instead of using Image
+	Build *BuildConfig
+	// FakeClock sets the time perceived by the container, see SetFakeClock
+	FakeClock *FakeClock
+	// FaketimeLibrary is the in-container path of libfaketime loaded for
+	// FakeClock; empty derives it, see FakeClockEnvironment
+	FaketimeLibrary string
+	// KeepVolumes keeps the container's anonymous volumes when it is terminated
+	KeepVolumes bool
+	// Volumes bind-mount host paths into the container
+	Volumes []container.VolumeMapping
+	// Annotations are test metadata tracked by the testkit only; they are
+	// not passed to Docker
+	Annotations map[string]string
+	// Networks are the user-defined networks the container joins, with the
+	// aliases it is reachable under on each network
+	Networks       []string
+	NetworkAliases map[string][]string
+	HealthCheck    *dockercontainer.HealthConfig
+	// ReadyTimeout bounds the default readiness strategies; zero uses the
+	// package-wide default, see SetDefaultReadyTimeout
+	ReadyTimeout time.Duration
+	// WaitStrategies are readiness strategies applied in addition to the
+	// container type's default strategies
+	WaitStrategies []wait.Strategy
+	// ReplaceDefaultWait makes WaitStrategies replace the default strategies
+	// instead of adding to them, for images the defaults do not fit
+	ReplaceDefaultWait bool
+	// ReadyLog replaces the log line a database container's default strategy
+	// waits for, for images that log a different ready message
+	ReadyLog string
+}
+
+// ConfigModifier returns a modifier applying the container-level settings of
+// the configuration to the Docker container config before container creation
+func (c *ContainerConfig) ConfigModifier() func(*dockercontainer.Config) {
+	return func(containerConfig *dockercontainer.Config) {
+		if c.HealthCheck != nil {
+			containerConfig.Healthcheck = c.HealthCheck
+		}
+	}
+}
+
+var (
+	readyTimeoutMutex   sync.RWMutex
+	defaultReadyTimeout = 30 * time.Second
+)
+
+// SetDefaultReadyTimeout sets the readiness timeout of every container that
+// does not set its own; a zero or negative timeout restores the 30s default
+func SetDefaultReadyTimeout(timeout time.Duration) {
+	readyTimeoutMutex.Lock()
+	defer readyTimeoutMutex.Unlock()
+
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	defaultReadyTimeout = timeout
+}
+
+// DefaultReadyTimeout returns the package-wide default readiness timeout
+func DefaultReadyTimeout() time.Duration {
+	readyTimeoutMutex.RLock()
+	defer readyTimeoutMutex.RUnlock()
+
+	return defaultReadyTimeout
+}
+
+// ReadinessTimeout returns the readiness timeout of the container, falling back
+// to the package-wide default
+func (c *ContainerConfig) ReadinessTimeout() time.Duration {
+	if c.ReadyTimeout > 0 {
+		return c.ReadyTimeout
+	}
+	return DefaultReadyTimeout()
+}
+
+// WaitStrategy combines the given default readiness strategies with any
+// additional strategies configured on the container, or returns only the
+// configured strategies when they replace the defaults
+func (c *ContainerConfig) WaitStrategy(defaults ...wait.Strategy) wait.Strategy {
+	if c.ReplaceDefaultWait && len(c.WaitStrategies) > 0 {
+		defaults = nil
+	}
+
+	strategies := make([]wait.Strategy, 0, len(defaults)+len(c.WaitStrategies))
+	strategies = append(strategies, defaults...)
+	strategies = append(strategies, c.WaitStrategies...)
+
+	if len(strategies) == 1 {
+		return strategies[0]
+	}
+	return wait.ForAll(strategies...)
+}
+
+// InitScriptFiles returns the files copying the init scripts into the init
+// script directory. Scripts are prefixed with their position so that the
+// entrypoint, which runs them in name order, runs them in the order added.
+func (c *ContainerConfig) InitScriptFiles() []testcontainers.ContainerFile {
+	if c.InitScriptDir == "" {
+		return nil
+	}
+
+	files := make([]testcontainers.ContainerFile, len(c.InitScripts))
+	for i, script := range c.InitScripts {
+		files[i] = testcontainers.ContainerFile{
+			HostFilePath:      script,
+			ContainerFilePath: path.Join(c.InitScriptDir, fmt.Sprintf("%03d-%s", i, filepath.Base(script))),
+			FileMode:          0o644,
+		}
+	}
+	return files
+}
+
+// BuildConfig describes how to build the container image from a Dockerfile,
+// with a build context that is either a host directory or a tar archive
+type BuildConfig struct {
+	Context        string    // Host directory of the build context
+	ContextArchive io.Reader // Tar archive of the build context, used when Context is empty
+	Dockerfile     string    // Path of the Dockerfile within the context, "Dockerfile" if empty
+
+	archive []byte
+}
+
+// FromDockerfile returns the testcontainers build request. An in-memory context
+// archive is buffered on first use so that the image can be rebuilt when the
+// container is recreated.
+func (b *BuildConfig) FromDockerfile() (testcontainers.FromDockerfile, error) {
+	build := testcontainers.FromDockerfile{
+		Context:    b.Context,
+		Dockerfile: b.Dockerfile,
+	}
+
+	if b.Context == "" && b.ContextArchive != nil {
+		if b.archive == nil {
+			archive, err := io.ReadAll(b.ContextArchive)
+			if err != nil {
+				return build, fmt.Errorf("failed to read build context archive: %w", err)
+			}
+			b.archive = archive
+		}
+		build.ContextArchive = bytes.NewReader(b.archive)
+	}
+
+	return build, nil
+}
+
+// DeviceRequest describes a request for host devices, such as GPUs, to be
+// made available to the container
+type DeviceRequest struct {
+	Driver       string   // e.g. "nvidia"
+	Count        int      // -1 requests all available devices
+	Capabilities []string // e.g. []string{"gpu"}
+}
+
+// Ulimit describes a resource limit applied to the container processes
+type Ulimit struct {
+	Name string // e.g. "nofile"
+	Soft int64
+	Hard int64
+}
+
+// HostConfigModifier returns a modifier applying the host-level settings of
+// the configuration to the Docker host config before container creation
+func (c *ContainerConfig) HostConfigModifier() func(*dockercontainer.HostConfig) {
+	return func(hostConfig *dockercontainer.HostConfig) {
+		hostConfig.CapAdd = append(hostConfig.CapAdd, c.CapAdd...)
+		hostConfig.CapDrop = append(hostConfig.CapDrop, c.CapDrop...)
+		hostConfig.DNS = append(hostConfig.DNS, c.DNS...)
+		hostConfig.DNSSearch = append(hostConfig.DNSSearch, c.DNSSearch...)
+		for _, device := range c.Devices {
+			request := dockercontainer.DeviceRequest{
+				Driver: device.Driver,
+				Count:  device.Count,
+			}
+			if len(device.Capabilities) > 0 {
+				request.Capabilities = [][]string{device.Capabilities}
+			}
+			hostConfig.DeviceRequests = append(hostConfig.DeviceRequests, request)
+		}
+		if c.LogDriver != "" {
+			hostConfig.LogConfig = dockercontainer.LogConfig{
+				Type:   c.LogDriver,
+				Config: c.LogDriverOptions,
+			}
+		}
+		for _, volume := range c.Volumes {
+			hostConfig.Binds = append(hostConfig.Binds, volume.Source+":"+volume.Target)
+		}
+		if c.DockerSocket {
+			bind := DockerSocketPath + ":" + DockerSocketPath
+			if !c.DockerSocketWritable {
+				bind += ":ro"
+			}
+			hostConfig.Binds = append(hostConfig.Binds, bind)
+			if gid, ok := socketGroup(DockerSocketPath); ok {
+				hostConfig.GroupAdd = append(hostConfig.GroupAdd, gid)
+			}
+		}
+		if c.Privileged {
+			hostConfig.Privileged = true
+		}
+		if c.ReadOnlyRootFS {
+			hostConfig.ReadonlyRootfs = true
+		}
+		if len(c.TmpfsPaths) > 0 {
+			if hostConfig.Tmpfs == nil {
+				hostConfig.Tmpfs = make(map[string]string, len(c.TmpfsPaths))
+			}
+			for _, path := range c.TmpfsPaths {
+				hostConfig.Tmpfs[path] = "rw"
+			}
+		}
+		for _, ulimit := range c.Ulimits {
+			hostConfig.Ulimits = append(hostConfig.Ulimits, &units.Ulimit{
+				Name: ulimit.Name,
+				Soft: ulimit.Soft,
+				Hard: ulimit.Hard,
+			})
+		}
+	}
+}
+
+// ValidateVolumes checks that every volume mounts an absolute host path at an
+// absolute container path, returning container.ConfigErrors listing every
+// invalid volume. A relative source would be taken by Docker as the name of a
+// named volume rather than a host path.
+func (c *ContainerConfig) ValidateVolumes() error {
+	var errs container.ConfigErrors
+	for i, volume := range c.Volumes {
+		if !filepath.IsAbs(volume.Source) {
+			errs.Add(fmt.Sprintf("volumes[%d].source", i), fmt.Sprintf("host path %q must be absolute", volume.Source))
+		}
+		if !path.IsAbs(volume.Target) {
+			errs.Add(fmt.Sprintf("volumes[%d].target", i), fmt.Sprintf("container path %q must be absolute", volume.Target))
+		}
+	}
+	return errs.ErrorOrNil()
 }
 
-// NewDockerContainer creates a new DockerContainer with the given configuration
+// DockerSocketPath is the path of the Docker socket on the host and in the
+// container
+const DockerSocketPath = "/var/run/docker.sock"
+
+// LogsDisabled reports whether the log driver discards the container output,
+// making it unavailable to Logs and FollowLogs
+func (c *ContainerConfig) LogsDisabled() bool {
+	return c.LogDriver == "none"
+}
+
+// NewDockerContainer creates a new DockerContainer with the given
+// configuration, registering it in ManagedContainers until it is stopped
 func NewDockerContainer(config *ContainerConfig) *DockerContainer {
-	return &DockerContainer{
+	d := &DockerContainer{
 		config: config,
 	}
+	register(d)
+	return d
 }
 
-// SetContainer sets the underlying testcontainers.Container
-func (d *DockerContainer) SetContainer(c testcontainers.Container) {
+// SetContainer sets the underlying backend container; clearing it
+// deregisters the container from ManagedContainers
+func (d *DockerContainer) SetContainer(c BackendContainer) {
 	d.container = c
+	d.logFanOut = nil
+	d.followingLogs = false
+	d.starts = 0
+	d.invalidateState()
+	if c == nil {
+		deregister(d)
+	}
 }
 
 // ID returns the unique identifier of the container
@@ -44,9 +419,9 @@ func (d *DockerContainer) ID() string {
 	return d.config.ID
 }
 
-// Name returns the name of the container
+// Name returns the name of the container, including the container name prefix
 func (d *DockerContainer) Name() string {
-	return d.config.Name
+	return PrefixedName(d.config.Name)
 }
 
 // Image returns the image of the container
@@ -64,8 +439,31 @@ func (d *DockerContainer) Start(ctx context.Context) error {
 		}
 	}
 
-	err := d.container.Start(ctx)
+	if len(d.logConsumers) > 0 && d.config.LogsDisabled() {
+		return d.logsDisabledError("start")
+	}
+
+	if err := d.initClock(ctx); err != nil {
+		return err
+	}
+
+	release, err := acquireStartSlot(ctx)
+	if err != nil {
+		return &container.ContainerError{
+			Operation: "start",
+			Container: d.ID(),
+			Message:   "failed to start container",
+			Cause:     err,
+		}
+	}
+
+	err = d.container.Start(ctx)
+	release()
+	d.invalidateState()
 	if err != nil {
+		if mismatch := d.archMismatchError(ctx, "start", err); mismatch != nil {
+			return mismatch
+		}
 		return &container.ContainerError{
 			Operation: "start",
 			Container: d.ID(),
@@ -74,10 +472,76 @@ func (d *DockerContainer) Start(ctx context.Context) error {
 		}
 	}
 
+	d.starts++
+	// A container started again after Stop is managed again
+	register(d)
+
+	if len(d.logConsumers) > 0 {
+		if err := d.startFollowingLogs(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// Stop stops the container
+// execFormatError is the error the kernel reports, and Docker logs, when the
+// entrypoint binary was built for another CPU architecture
+const execFormatError = "exec format error"
+
+// maxArchCheckLogBytes bounds the logs scanned for execFormatError
+const maxArchCheckLogBytes = 64 * 1024
+
+// archMismatchError returns an error matching container.ErrArchMismatch if the
+// container failed because its image was built for another architecture than
+// the host's, as shown by an exec format error in its logs, nil otherwise
+func (d *DockerContainer) archMismatchError(ctx context.Context, operation string, cause error) error {
+	if d.container == nil {
+		return nil
+	}
+
+	logCtx, cancel := CleanupContext(ctx)
+	defer cancel()
+
+	logs, err := d.container.Logs(logCtx)
+	if err != nil {
+		return nil
+	}
+	defer logs.Close()
+
+	output, err := io.ReadAll(io.LimitReader(logs, maxArchCheckLogBytes))
+	if err != nil || !strings.Contains(string(output), execFormatError) {
+		return nil
+	}
+
+	message := fmt.Sprintf("image %s cannot run on this host (%s)", d.config.Image, execFormatError)
+	if imageArch, hostArch, err := ImageArchitecture(logCtx, d.container.GetContainerID()); err == nil {
+		message = fmt.Sprintf("image %s is built for %s but the host is %s", d.config.Image, imageArch, hostArch)
+	}
+
+	return &container.ContainerError{
+		Operation: operation,
+		Container: d.ID(),
+		Message:   message + "; select a matching image variant with WithPlatform or enable emulation",
+		Cause:     fmt.Errorf("%w: %v", container.ErrArchMismatch, cause),
+	}
+}
+
+// CleanupTimeout bounds the time spent stopping or removing a container
+const CleanupTimeout = 30 * time.Second
+
+// CleanupContext returns a context for cleanup operations that keeps the
+// values of ctx but not its cancellation, bounded by CleanupTimeout. Cleanup
+// thus still runs when the test's context has already been cancelled, e.g.
+// after a timeout, instead of leaking the container.
+func CleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), CleanupTimeout)
+}
+
+// Stop stops the container. Its anonymous volumes are kept, since the stopped
+// container still uses them and a restart must find its data; Terminate
+// removes them. The caller's context is not used for cancellation, see
+// CleanupContext.
 func (d *DockerContainer) Stop(ctx context.Context) error {
 	if d.container == nil {
 		return &container.ContainerError{
@@ -87,7 +551,15 @@ func (d *DockerContainer) Stop(ctx context.Context) error {
 		}
 	}
 
-	err := d.container.Stop(ctx, nil)
+	if err := d.StopFollowingLogs(); err != nil {
+		return err
+	}
+
+	cleanupCtx, cancel := CleanupContext(ctx)
+	defer cancel()
+
+	err := d.container.Stop(cleanupCtx, nil)
+	d.invalidateState()
 	if err != nil {
 		return &container.ContainerError{
 			Operation: "stop",
@@ -97,24 +569,136 @@ func (d *DockerContainer) Stop(ctx context.Context) error {
 		}
 	}
 
+	deregister(d)
+	return nil
+}
+
+// AddInitScript adds a script run by the image's entrypoint on first start,
+// such as a .sql file for a database container. Scripts run in the order added;
+// a script that cannot be read fails container creation.
+func (d *DockerContainer) AddInitScript(hostPath string) {
+	d.config.InitScripts = append(d.config.InitScripts, hostPath)
+}
+
+// AddReadyExec makes readiness depend on a command run inside the container
+// exiting 0, polled every interval for at most timeout. Running the probe
+// inside the container also exercises in-container networking, which host port
+// checks do not.
+func (d *DockerContainer) AddReadyExec(cmd []string, interval, timeout time.Duration) {
+	strategy := wait.ForExec(cmd).
+		WithPollInterval(interval).
+		WithStartupTimeout(timeout)
+	d.config.WaitStrategies = append(d.config.WaitStrategies, strategy)
+}
+
+// AddLogQuietWait makes readiness depend on the container logging nothing new
+// for quietPeriod, waiting at most timeout. This is a heuristic for images
+// that log continuously while starting and have no clear ready marker.
+func (d *DockerContainer) AddLogQuietWait(quietPeriod, timeout time.Duration) {
+	d.config.WaitStrategies = append(d.config.WaitStrategies, &logQuietStrategy{
+		container:   d,
+		quietPeriod: quietPeriod,
+		timeout:     timeout,
+	})
+}
+
+// Terminate removes the container along with its anonymous volumes, unless
+// the configuration keeps them. A terminated container is recreated by the
+// next Start. The caller's context is not used for cancellation, see CleanupContext.
+func (d *DockerContainer) Terminate(ctx context.Context) error {
+	if d.container == nil {
+		return nil
+	}
+
+	if err := d.StopFollowingLogs(); err != nil {
+		return err
+	}
+
+	cleanupCtx, cancel := CleanupContext(ctx)
+	defer cancel()
+
+	var err error
+	if d.config.KeepVolumes {
+		err = RemoveContainer(cleanupCtx, d.container.GetContainerID(), false)
+	} else {
+		// testcontainers removes anonymous volumes along with the container
+		err = d.container.Terminate(cleanupCtx)
+	}
+	if err != nil {
+		return &container.ContainerError{
+			Operation: "terminate",
+			Container: d.ID(),
+			Message:   "failed to remove container",
+			Cause:     err,
+		}
+	}
+
+	d.container = nil
+	d.logFanOut = nil
+	d.starts = 0
+	d.clockInitialized = false
+	d.invalidateState()
+	deregister(d)
 	return nil
 }
 
-// IsRunning returns true if the container is currently running
+var (
+	stateCacheMutex sync.RWMutex
+	stateCacheTTL   = 500 * time.Millisecond
+)
+
+// SetStateCacheTTL sets how long IsRunning reuses the running state it last
+// read from the daemon, reducing daemon load when many containers are polled;
+// zero or a negative TTL disables caching
+func SetStateCacheTTL(ttl time.Duration) {
+	stateCacheMutex.Lock()
+	defer stateCacheMutex.Unlock()
+
+	stateCacheTTL = ttl
+}
+
+// StateCacheTTL returns how long IsRunning reuses the running state
+func StateCacheTTL() time.Duration {
+	stateCacheMutex.RLock()
+	defer stateCacheMutex.RUnlock()
+
+	return stateCacheTTL
+}
+
+// IsRunning returns true if the container is currently running. The state is
+// cached for StateCacheTTL, except across Start, Stop and Terminate, which
+// invalidate it.
 func (d *DockerContainer) IsRunning() bool {
 	if d.container == nil {
 		return false
 	}
 
+	d.stateMutex.Lock()
+	defer d.stateMutex.Unlock()
+
+	if ttl := StateCacheTTL(); ttl > 0 && !d.stateCachedAt.IsZero() && time.Since(d.stateCachedAt) < ttl {
+		return d.cachedRunning
+	}
+
 	ctx := context.Background()
 	state, err := d.container.State(ctx)
 	if err != nil {
 		return false
 	}
 
+	d.cachedRunning = state.Running
+	d.stateCachedAt = time.Now()
 	return state.Running
 }
 
+// invalidateState discards the running state cached by IsRunning
+func (d *DockerContainer) invalidateState() {
+	d.stateMutex.Lock()
+	defer d.stateMutex.Unlock()
+
+	d.stateCachedAt = time.Time{}
+}
+
 // Host returns the host address where the container is accessible
 func (d *DockerContainer) Host() string {
 	if d.container == nil {
@@ -154,6 +738,51 @@ func (d *DockerContainer) Port(internal int) (int, error) {
 	return port.Int(), nil
 }
 
+// InternalPort returns the primary port the service listens on inside the
+// container, the first configured port, or 0 if none is configured
+func (d *DockerContainer) InternalPort() int {
+	if len(d.config.Ports) == 0 {
+		return 0
+	}
+	return d.config.Ports[0].Internal
+}
+
+// Ports returns every exposed internal TCP port mapped to its external port,
+// inspecting the container once
+func (d *DockerContainer) Ports(ctx context.Context) (map[int]int, error) {
+	if d.container == nil {
+		return nil, &container.ContainerError{
+			Operation: "port",
+			Container: d.config.ID,
+			Message:   "container not started",
+		}
+	}
+
+	portMap, err := d.container.Ports(ctx)
+	if err != nil {
+		return nil, &container.ContainerError{
+			Operation: "port",
+			Container: d.config.ID,
+			Message:   "failed to get mapped ports",
+			Cause:     err,
+		}
+	}
+
+	ports := make(map[int]int, len(portMap))
+	for port, bindings := range portMap {
+		if port.Proto() != "tcp" || len(bindings) == 0 {
+			continue
+		}
+		external, err := strconv.Atoi(bindings[0].HostPort)
+		if err != nil {
+			continue
+		}
+		ports[port.Int()] = external
+	}
+
+	return ports, nil
+}
+
 // ConnectionString returns the connection string for the container
 func (d *DockerContainer) ConnectionString() string {
 	host := d.Host()
@@ -196,6 +825,9 @@ func (d *DockerContainer) WaitForReady(ctx context.Context, timeout time.Duratio
 	for {
 		select {
 		case <-timeoutCtx.Done():
+			if mismatch := d.archMismatchError(ctx, "wait_for_ready", timeoutCtx.Err()); mismatch != nil {
+				return mismatch
+			}
 			return &container.ContainerError{
 				Operation: "wait_for_ready",
 				Container: d.config.ID,
@@ -235,6 +867,10 @@ func (d *DockerContainer) Logs(ctx context.Context) (io.Reader, error) {
 		}
 	}
 
+	if d.config.LogsDisabled() {
+		return nil, d.logsDisabledError("logs")
+	}
+
 	logs, err := d.container.Logs(ctx)
 	if err != nil {
 		return nil, &container.ContainerError{
@@ -248,6 +884,108 @@ func (d *DockerContainer) Logs(ctx context.Context) (io.Reader, error) {
 	return logs, nil
 }
 
+// logsDisabledError returns the error of an operation needing the container
+// output while the log driver discards it
+func (d *DockerContainer) logsDisabledError(operation string) error {
+	return &container.ContainerError{
+		Operation: operation,
+		Container: d.ID(),
+		Message:   fmt.Sprintf("container logs are unavailable with log driver %q", d.config.LogDriver),
+	}
+}
+
+// JoinNetwork attaches the container to a user-defined network under the given
+// aliases. It must be called before the container is started.
+func (d *DockerContainer) JoinNetwork(network string, aliases ...string) {
+	d.config.Networks = append(d.config.Networks, network)
+	if len(aliases) > 0 {
+		if d.config.NetworkAliases == nil {
+			d.config.NetworkAliases = make(map[string][]string)
+		}
+		d.config.NetworkAliases[network] = append(d.config.NetworkAliases[network], aliases...)
+	}
+}
+
+// NetworkAlias returns the first alias the container is reachable under on a
+// user-defined network, or an empty string if it has none
+func (d *DockerContainer) NetworkAlias() string {
+	for _, network := range d.config.Networks {
+		if aliases := d.config.NetworkAliases[network]; len(aliases) > 0 {
+			return aliases[0]
+		}
+	}
+	return ""
+}
+
+// FollowLogs registers a consumer that receives every line the container logs.
+// Consumers registered before Start begin receiving output once the container
+// has started; consumers registered on a running container attach immediately.
+func (d *DockerContainer) FollowLogs(consumer LogConsumer) error {
+	if d.config.LogsDisabled() {
+		return d.logsDisabledError("follow_logs")
+	}
+
+	d.logConsumers = append(d.logConsumers, consumer)
+	if d.logFanOut != nil {
+		d.logFanOut.add(consumer)
+	}
+
+	if d.container == nil || !d.IsRunning() {
+		return nil
+	}
+
+	return d.startFollowingLogs()
+}
+
+// StopFollowingLogs stops forwarding container output to registered log consumers
+func (d *DockerContainer) StopFollowingLogs() error {
+	if !d.followingLogs {
+		return nil
+	}
+
+	if err := d.container.StopLogProducer(); err != nil {
+		return &container.ContainerError{
+			Operation: "stop_following_logs",
+			Container: d.ID(),
+			Message:   "failed to stop log producer",
+			Cause:     err,
+		}
+	}
+
+	d.followingLogs = false
+	return nil
+}
+
+// startFollowingLogs starts the log producer feeding registered consumers
+func (d *DockerContainer) startFollowingLogs() error {
+	if d.followingLogs {
+		return nil
+	}
+
+	// Register the consumers once per backend container, which keeps them
+	// across restarts of its log producer
+	if d.logFanOut == nil {
+		d.logFanOut = newLogFanOut(d.logConsumers)
+		d.container.FollowOutput(d.logFanOut)
+	} else {
+		d.logFanOut.rewind()
+	}
+
+	// The producer outlives the call that started it, so it must not be bound
+	// to the caller's context
+	if err := d.container.StartLogProducer(context.Background()); err != nil {
+		return &container.ContainerError{
+			Operation: "follow_logs",
+			Container: d.ID(),
+			Message:   "failed to start log producer",
+			Cause:     err,
+		}
+	}
+
+	d.followingLogs = true
+	return nil
+}
+
 // Exec executes a command in the container
 func (d *DockerContainer) Exec(ctx context.Context, cmd []string) error {
 	if d.container == nil {
@@ -271,12 +1009,111 @@ func (d *DockerContainer) Exec(ctx context.Context, cmd []string) error {
 	return nil
 }
 
+// VerifyImageDigest verifies that the container runs the image with the given
+// digest, matching either the image ID or one of its repository digests
+func (d *DockerContainer) VerifyImageDigest(ctx context.Context, digest string) error {
+	if d.container == nil {
+		return &container.ContainerError{
+			Operation: "verify_image_digest",
+			Container: d.ID(),
+			Message:   "container not initialized",
+		}
+	}
+
+	imageID, repoDigests, err := ContainerImageDigests(ctx, d.container.GetContainerID())
+	if err != nil {
+		return &container.ContainerError{
+			Operation: "verify_image_digest",
+			Container: d.ID(),
+			Message:   "failed to inspect image",
+			Cause:     err,
+		}
+	}
+
+	if imageID == digest {
+		return nil
+	}
+	for _, repoDigest := range repoDigests {
+		if strings.HasSuffix(repoDigest, "@"+digest) {
+			return nil
+		}
+	}
+
+	return &container.ContainerError{
+		Operation: "verify_image_digest",
+		Container: d.ID(),
+		Message:   fmt.Sprintf("expected image digest %s, got image %s with digests %v", digest, imageID, repoDigests),
+	}
+}
+
+// ExecWithOutput executes a command inside the container and returns its exit
+// code and combined output. Unlike Exec, a non-zero exit code is not an error.
+func (d *DockerContainer) ExecWithOutput(ctx context.Context, cmd []string) (int, string, error) {
+	if d.container == nil {
+		return 0, "", &container.ContainerError{
+			Operation: "exec",
+			Container: d.ID(),
+			Message:   "container not initialized",
+		}
+	}
+
+	exitCode, reader, err := d.container.Exec(ctx, cmd, tcexec.Multiplexed())
+	if err != nil {
+		return 0, "", &container.ContainerError{
+			Operation: "exec",
+			Container: d.ID(),
+			Message:   fmt.Sprintf("failed to execute command: %v", cmd),
+			Cause:     err,
+		}
+	}
+
+	output, err := io.ReadAll(reader)
+	if err != nil {
+		return exitCode, "", &container.ContainerError{
+			Operation: "exec",
+			Container: d.ID(),
+			Message:   fmt.Sprintf("failed to read output of command: %v", cmd),
+			Cause:     err,
+		}
+	}
+
+	return exitCode, string(output), nil
+}
+
+// Commit captures the container's current filesystem state as a new image
+// tagged with the given reference. Data stored in volumes is not included.
+func (d *DockerContainer) Commit(ctx context.Context, imageRef string) error {
+	if d.container == nil {
+		return &container.ContainerError{
+			Operation: "commit",
+			Container: d.ID(),
+			Message:   "container not initialized",
+		}
+	}
+
+	if _, err := CommitContainer(ctx, d.container.GetContainerID(), imageRef); err != nil {
+		return &container.ContainerError{
+			Operation: "commit",
+			Container: d.ID(),
+			Message:   fmt.Sprintf("failed to commit container to image %s", imageRef),
+			Cause:     err,
+		}
+	}
+
+	return nil
+}
+
 // Config returns the container configuration
 func (d *DockerContainer) Config() *ContainerConfig {
 	return d.config
 }
 
-// Container returns the underlying testcontainers.Container
-func (d *DockerContainer) Container() testcontainers.Container {
+// ReadyTimeout returns how long the container may take to become ready
+func (d *DockerContainer) ReadyTimeout() time.Duration {
+	return d.config.ReadinessTimeout()
+}
+
+// Container returns the underlying backend container
+func (d *DockerContainer) Container() BackendContainer {
 	return d.container
 }
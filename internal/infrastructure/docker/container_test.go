@@ -0,0 +1,97 @@
+package docker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+
+	"github.com/fintechain/skeleton-testkit/internal/domain/container"
+)
+
+// collectLines returns a log consumer appending each line to lines
+func collectLines(lines *[]string) LogConsumer {
+	return func(stream, line string) {
+		*lines = append(*lines, stream+": "+line)
+	}
+}
+
+func TestLogFanOutSplitsFramesIntoLines(t *testing.T) {
+	var lines []string
+	fanOut := newLogFanOut([]LogConsumer{collectLines(&lines)})
+
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("first\r\nsecond\n")})
+
+	require.Equal(t, []string{"STDOUT: first", "STDOUT: second"}, lines)
+}
+
+func TestLogFanOutSkipsReplayedFrames(t *testing.T) {
+	var lines []string
+	fanOut := newLogFanOut([]LogConsumer{collectLines(&lines)})
+
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("started")})
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StderrLog, Content: []byte("warning")})
+
+	// A restarted log producer replays the output from the start
+	fanOut.rewind()
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("started")})
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StderrLog, Content: []byte("warning")})
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("restarted")})
+
+	require.Equal(t, []string{"STDOUT: started", "STDERR: warning", "STDOUT: restarted"}, lines)
+}
+
+func TestLogFanOutAddedConsumerReceivesNewFrames(t *testing.T) {
+	var first, second []string
+	fanOut := newLogFanOut([]LogConsumer{collectLines(&first)})
+
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("before")})
+	fanOut.add(collectLines(&second))
+	fanOut.Accept(testcontainers.Log{LogType: testcontainers.StdoutLog, Content: []byte("after")})
+
+	require.Equal(t, []string{"STDOUT: before", "STDOUT: after"}, first)
+	require.Equal(t, []string{"STDOUT: after"}, second)
+}
+
+func TestValidateVolumes(t *testing.T) {
+	tests := []struct {
+		name    string
+		volumes []container.VolumeMapping
+		want    container.ConfigErrors
+	}{
+		{
+			name:    "absolute paths",
+			volumes: []container.VolumeMapping{{Source: "/tmp/data", Target: "/data"}},
+		},
+		{
+			name:    "relative source",
+			volumes: []container.VolumeMapping{{Source: "data", Target: "/data"}},
+			want:    container.ConfigErrors{{Field: "volumes[0].source", Reason: `host path "data" must be absolute`}},
+		},
+		{
+			name: "relative target",
+			volumes: []container.VolumeMapping{
+				{Source: "/tmp/data", Target: "/data"},
+				{Source: "/tmp/seed", Target: "seed"},
+			},
+			want: container.ConfigErrors{{Field: "volumes[1].target", Reason: `container path "seed" must be absolute`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &ContainerConfig{Volumes: tt.volumes}
+
+			err := config.ValidateVolumes()
+			if tt.want == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs container.ConfigErrors
+			require.True(t, errors.As(err, &errs))
+			require.Equal(t, tt.want, errs)
+		})
+	}
+}
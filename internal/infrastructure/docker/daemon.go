@@ -0,0 +1,17 @@
+package docker
+
+import (
+	"context"
+	"slices"
+)
+
+// HasGPURuntime reports whether the container runtime has the NVIDIA container
+// runtime installed, which is required to satisfy GPU device requests
+func HasGPURuntime(ctx context.Context) (bool, error) {
+	info, err := Backend().Info(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(info.Runtimes, "nvidia"), nil
+}
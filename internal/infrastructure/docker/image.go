@@ -0,0 +1,168 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// imageLoadMessage is a single message of the Docker image load response stream
+type imageLoadMessage struct {
+	Stream string `json:"stream"`
+	Error  string `json:"error"`
+}
+
+// LoadImage loads an image archive produced by `docker save` into the container
+// runtime of the current backend and returns the reference of the loaded image
+func LoadImage(ctx context.Context, tarPath string) (string, error) {
+	archive, err := os.Open(tarPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open image archive %s: %w", tarPath, err)
+	}
+	defer archive.Close()
+
+	imageRef, err := Backend().LoadImage(ctx, archive)
+	if err != nil {
+		return "", fmt.Errorf("failed to load image archive %s: %w", tarPath, err)
+	}
+
+	return imageRef, nil
+}
+
+// CommitContainer commits the current filesystem state of a container to a new
+// image tagged with the given reference and returns the new image ID
+func CommitContainer(ctx context.Context, containerID, imageRef string) (string, error) {
+	return Backend().CommitContainer(ctx, containerID, imageRef)
+}
+
+// ContainerImageDigests returns the ID of the image a container runs and the
+// repository digests of that image
+func ContainerImageDigests(ctx context.Context, containerID string) (string, []string, error) {
+	image, err := Backend().ContainerImage(ctx, containerID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return image.ID, image.RepoDigests, nil
+}
+
+// ImageArchitecture returns the CPU architecture the image of a container was
+// built for and the architecture of the host, both in GOARCH notation
+func ImageArchitecture(ctx context.Context, containerID string) (string, string, error) {
+	backend := Backend()
+
+	image, err := backend.ContainerImage(ctx, containerID)
+	if err != nil {
+		return "", "", err
+	}
+
+	runtime, err := backend.Info(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	return normalizeArch(image.Architecture), normalizeArch(runtime.Architecture), nil
+}
+
+// normalizeArch converts the kernel architecture names the Docker daemon
+// reports, such as x86_64 and aarch64, to GOARCH notation
+func normalizeArch(arch string) string {
+	switch arch {
+	case "x86_64":
+		return "amd64"
+	case "aarch64":
+		return "arm64"
+	case "armv7l", "armhf":
+		return "arm"
+	}
+	return arch
+}
+
+// CheckImageAvailable returns an error unless the image exists locally or can
+// be resolved in its registry, without pulling it. Private registries are
+// queried anonymously, so their images must be present locally.
+func CheckImageAvailable(ctx context.Context, imageRef string) error {
+	backend := Backend()
+
+	if _, err := backend.InspectImage(ctx, imageRef); err == nil {
+		return nil
+	}
+
+	if err := backend.ResolveImage(ctx, imageRef); err != nil {
+		return fmt.Errorf("image %s not found locally or in its registry: %w", imageRef, err)
+	}
+	return nil
+}
+
+// imageVolumes returns the sorted container paths the image declares as
+// volumes, pulling the image if it is not available locally
+func imageVolumes(ctx context.Context, imageRef, platform string) ([]string, error) {
+	backend := Backend()
+
+	info, err := backend.InspectImage(ctx, imageRef)
+	if err != nil {
+		if pullErr := backend.PullImage(ctx, imageRef, platform); pullErr != nil {
+			return nil, fmt.Errorf("image %s not found locally and could not be pulled: %w", imageRef, pullErr)
+		}
+		if info, err = backend.InspectImage(ctx, imageRef); err != nil {
+			return nil, err
+		}
+	}
+
+	volumes := append([]string(nil), info.Volumes...)
+	sort.Strings(volumes)
+	return volumes, nil
+}
+
+// PinImageDigest returns the image reference pinned to the given digest,
+// replacing any tag or digest of the reference
+func PinImageDigest(imageRef, digest string) string {
+	repo := imageRef
+	if at := strings.Index(repo, "@"); at >= 0 {
+		repo = repo[:at]
+	}
+	// A colon after the last slash separates the tag; earlier ones belong to a registry port
+	if colon := strings.LastIndex(repo, ":"); colon > strings.LastIndex(repo, "/") {
+		repo = repo[:colon]
+	}
+	return repo + "@" + digest
+}
+
+// parseLoadedImageRef extracts the loaded image reference from an image load
+// response stream, preferring a tagged reference over a bare image ID
+func parseLoadedImageRef(body io.Reader) (string, error) {
+	var imageID string
+	decoder := json.NewDecoder(body)
+
+	for {
+		var msg imageLoadMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", fmt.Errorf("failed to decode image load response: %w", err)
+		}
+
+		if msg.Error != "" {
+			return "", fmt.Errorf("%s", msg.Error)
+		}
+
+		stream := strings.TrimSpace(msg.Stream)
+		if ref, ok := strings.CutPrefix(stream, "Loaded image: "); ok {
+			return ref, nil
+		}
+		if id, ok := strings.CutPrefix(stream, "Loaded image ID: "); ok && imageID == "" {
+			imageID = id
+		}
+	}
+
+	if imageID == "" {
+		return "", fmt.Errorf("archive did not contain an image")
+	}
+
+	return imageID, nil
+}
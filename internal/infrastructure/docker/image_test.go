@@ -0,0 +1,104 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDigest = "sha256:4f6c4e4d7a0b3c2e1f9d8c7b6a5e4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b9a8f7e"
+
+func TestPinImageDigest(t *testing.T) {
+	tests := []struct {
+		name     string
+		imageRef string
+		want     string
+	}{
+		{"untagged", "postgres", "postgres@" + testDigest},
+		{"tagged", "postgres:15", "postgres@" + testDigest},
+		{"already pinned", "postgres:15@sha256:0000", "postgres@" + testDigest},
+		{"registry with port", "registry.local:5000/team/app", "registry.local:5000/team/app@" + testDigest},
+		{"registry with port and tag", "registry.local:5000/team/app:v1.2", "registry.local:5000/team/app@" + testDigest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, PinImageDigest(tt.imageRef, testDigest))
+		})
+	}
+}
+
+func TestParseLoadedImageRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "tagged image",
+			body: `{"stream":"Loaded image: skeleton-app:test\n"}`,
+			want: "skeleton-app:test",
+		},
+		{
+			name: "untagged image",
+			body: `{"stream":"Loaded image ID: sha256:abc\n"}`,
+			want: "sha256:abc",
+		},
+		{
+			name: "tag preferred over ID",
+			body: `{"stream":"Loaded image ID: sha256:abc\n"}
+{"stream":"Loaded image: skeleton-app:test\n"}`,
+			want: "skeleton-app:test",
+		},
+		{
+			name:    "daemon error",
+			body:    `{"error":"invalid tar header"}`,
+			wantErr: "invalid tar header",
+		},
+		{
+			name:    "no image",
+			body:    `{"stream":"\n"}`,
+			wantErr: "archive did not contain an image",
+		},
+		{
+			name:    "malformed response",
+			body:    `{"stream":`,
+			wantErr: "failed to decode image load response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLoadedImageRef(strings.NewReader(tt.body))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeArch(t *testing.T) {
+	tests := []struct {
+		arch string
+		want string
+	}{
+		{"x86_64", "amd64"},
+		{"aarch64", "arm64"},
+		{"armv7l", "arm"},
+		{"armhf", "arm"},
+		{"amd64", "amd64"},
+		{"arm64", "arm64"},
+		{"s390x", "s390x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arch, func(t *testing.T) {
+			require.Equal(t, tt.want, normalizeArch(tt.arch))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+var (
+	startSlotsMutex sync.RWMutex
+	// startSlots bounds concurrent container creations and starts; nil means unlimited
+	startSlots chan struct{}
+)
+
+// SetMaxConcurrentStarts limits how many containers are created or started at
+// the same time across the process. A limit of zero or less removes the limit.
+func SetMaxConcurrentStarts(n int) {
+	startSlotsMutex.Lock()
+	defer startSlotsMutex.Unlock()
+
+	if n <= 0 {
+		startSlots = nil
+		return
+	}
+	startSlots = make(chan struct{}, n)
+}
+
+// acquireStartSlot waits for a free start slot and returns the function
+// releasing it
+func acquireStartSlot(ctx context.Context) (func(), error) {
+	startSlotsMutex.RLock()
+	slots := startSlots
+	startSlotsMutex.RUnlock()
+
+	if slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for a container start slot: %w", ctx.Err())
+	}
+}
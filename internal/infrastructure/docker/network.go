@@ -4,9 +4,25 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/testcontainers/testcontainers-go"
+
 	"github.com/fintechain/skeleton-testkit/internal/domain/container"
 )
 
+// CreateNetwork creates a user-defined bridge network with the given name
+func CreateNetwork(ctx context.Context, name string) (testcontainers.Network, error) {
+	network, err := testcontainers.GenericNetwork(ctx, testcontainers.GenericNetworkRequest{
+		NetworkRequest: testcontainers.NetworkRequest{
+			Name:           name,
+			CheckDuplicate: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network %s: %w", name, err)
+	}
+	return network, nil
+}
+
 // PortManager manages port mappings and allocations
 type PortManager struct {
 	allocatedPorts map[string][]int
@@ -101,39 +117,40 @@ func (c *ContainerLifecycleManager) UnregisterContainer(containerID string) {
 	c.portManager.DeallocateAllPorts(containerID)
 }
 
-// StartAll starts all registered containers
+// StartAll starts all registered containers, collecting every failure
 func (c *ContainerLifecycleManager) StartAll(ctx context.Context) error {
+	errs := container.NewMultiError()
 	for _, dockerContainer := range c.containers {
 		if !dockerContainer.IsRunning() {
 			if err := dockerContainer.Start(ctx); err != nil {
-				return &container.ContainerError{
+				errs.Add(&container.ContainerError{
 					Operation: "start_all",
 					Container: dockerContainer.ID(),
 					Message:   "failed to start container during start all",
 					Cause:     err,
-				}
+				})
 			}
 		}
 	}
-	return nil
+	return errs.ErrorOrNil()
 }
 
-// StopAll stops all registered containers
+// StopAll stops all registered containers, collecting every failure
 func (c *ContainerLifecycleManager) StopAll(ctx context.Context) error {
-	var lastErr error
+	errs := container.NewMultiError()
 	for _, dockerContainer := range c.containers {
 		if dockerContainer.IsRunning() {
 			if err := dockerContainer.Stop(ctx); err != nil {
-				lastErr = &container.ContainerError{
+				errs.Add(&container.ContainerError{
 					Operation: "stop_all",
 					Container: dockerContainer.ID(),
 					Message:   "failed to stop container during stop all",
 					Cause:     err,
-				}
+				})
 			}
 		}
 	}
-	return lastErr
+	return errs.ErrorOrNil()
 }
 
 // GetContainer returns a container by ID
@@ -0,0 +1,313 @@
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// providerBackend is a ContainerBackend backed by a testcontainers provider,
+// using the Docker API for the runtime operations. Podman serves the same API
+// on its Docker-compatible socket.
+type providerBackend struct {
+	name         string
+	providerType testcontainers.ProviderType
+}
+
+// NewDockerBackend creates a backend running containers on Docker, or on any
+// runtime auto-detected from DOCKER_HOST by testcontainers
+func NewDockerBackend() ContainerBackend {
+	return &providerBackend{
+		name:         "docker",
+		providerType: testcontainers.ProviderDefault,
+	}
+}
+
+// NewPodmanBackend creates a backend running containers on Podman through its
+// Docker-compatible socket
+func NewPodmanBackend() ContainerBackend {
+	return &providerBackend{
+		name:         "podman",
+		providerType: testcontainers.ProviderPodman,
+	}
+}
+
+// Name returns the name of the backend
+func (p *providerBackend) Name() string {
+	return p.name
+}
+
+// CreateContainer creates, but does not start, a container for the request
+func (p *providerBackend) CreateContainer(ctx context.Context, req testcontainers.ContainerRequest) (BackendContainer, error) {
+	return testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		ProviderType:     p.providerType,
+		Started:          false, // Containers are started explicitly
+	})
+}
+
+// RemoveContainer force-removes a container, optionally along with its
+// anonymous volumes
+func (p *providerBackend) RemoveContainer(ctx context.Context, containerID string, removeVolumes bool) error {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	err = cli.ContainerRemove(ctx, containerID, types.ContainerRemoveOptions{
+		Force:         true,
+		RemoveVolumes: removeVolumes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove container %s: %w", containerID, err)
+	}
+
+	return nil
+}
+
+// ListContainers returns the IDs of all containers carrying the label
+func (p *providerBackend) ListContainers(ctx context.Context, label string) ([]string, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", label)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers labeled %s: %w", label, err)
+	}
+
+	ids := make([]string, len(containers))
+	for i, c := range containers {
+		ids[i] = c.ID
+	}
+	return ids, nil
+}
+
+// ContainerLogs returns the multiplexed output stream of a container
+func (p *providerBackend) ContainerLogs(ctx context.Context, containerID string, opts LogOptions) (io.ReadCloser, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+	}
+	if !opts.Since.IsZero() {
+		options.Since = fmt.Sprintf("%d.%09d", opts.Since.Unix(), opts.Since.Nanosecond())
+	}
+
+	logs, err := cli.ContainerLogs(ctx, containerID, options)
+	if err != nil {
+		cli.Close()
+		return nil, fmt.Errorf("failed to read logs of container %s: %w", containerID, err)
+	}
+
+	return &clientReadCloser{ReadCloser: logs, client: cli}, nil
+}
+
+// clientReadCloser closes the Docker client along with the stream read from it
+type clientReadCloser struct {
+	io.ReadCloser
+	client *testcontainers.DockerClient
+}
+
+// Close closes the stream and the client
+func (c *clientReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.client.Close()
+	return err
+}
+
+// CommitContainer commits the filesystem of a container to a new image
+func (p *providerBackend) CommitContainer(ctx context.Context, containerID, imageRef string) (string, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer cli.Close()
+
+	resp, err := cli.ContainerCommit(ctx, containerID, types.ContainerCommitOptions{
+		Reference: imageRef,
+		Comment:   "snapshot created by skeleton-testkit",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to commit container %s to %s: %w", containerID, imageRef, err)
+	}
+
+	return resp.ID, nil
+}
+
+// ContainerImage describes the image a container runs
+func (p *providerBackend) ContainerImage(ctx context.Context, containerID string) (ImageInfo, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return ImageInfo{}, err
+	}
+	defer cli.Close()
+
+	info, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return ImageInfo{}, fmt.Errorf("failed to inspect container %s: %w", containerID, err)
+	}
+
+	return inspectImage(ctx, cli, info.Image)
+}
+
+// InspectImage describes a locally available image
+func (p *providerBackend) InspectImage(ctx context.Context, imageRef string) (ImageInfo, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return ImageInfo{}, err
+	}
+	defer cli.Close()
+
+	return inspectImage(ctx, cli, imageRef)
+}
+
+// inspectImage describes a locally available image using the client
+func inspectImage(ctx context.Context, cli *testcontainers.DockerClient, imageRef string) (ImageInfo, error) {
+	image, _, err := cli.ImageInspectWithRaw(ctx, imageRef)
+	if err != nil {
+		return ImageInfo{}, fmt.Errorf("failed to inspect image %s: %w", imageRef, err)
+	}
+
+	info := ImageInfo{
+		ID:           image.ID,
+		RepoDigests:  image.RepoDigests,
+		Architecture: image.Architecture,
+	}
+	if image.Config != nil {
+		for volume := range image.Config.Volumes {
+			info.Volumes = append(info.Volumes, volume)
+		}
+	}
+	return info, nil
+}
+
+// ResolveImage checks that an image can be resolved in its registry
+func (p *providerBackend) ResolveImage(ctx context.Context, imageRef string) error {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	if _, err := cli.DistributionInspect(ctx, imageRef, ""); err != nil {
+		return fmt.Errorf("failed to resolve image %s in its registry: %w", imageRef, err)
+	}
+	return nil
+}
+
+// PullImage pulls an image with the registry credentials of the Docker
+// configuration, like testcontainers does when creating a container
+func (p *providerBackend) PullImage(ctx context.Context, imageRef, platform string) error {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	options := types.ImagePullOptions{Platform: platform}
+	// Registries without credentials are pulled from anonymously
+	if _, auth, err := testcontainers.DockerImageAuth(ctx, imageRef); err == nil {
+		encoded, err := json.Marshal(auth)
+		if err != nil {
+			return fmt.Errorf("failed to encode registry credentials for %s: %w", imageRef, err)
+		}
+		options.RegistryAuth = base64.URLEncoding.EncodeToString(encoded)
+	}
+
+	progress, err := cli.ImagePull(ctx, imageRef, options)
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", imageRef, err)
+	}
+	defer progress.Close()
+
+	// The pull completes once its progress stream, which also reports errors
+	// occurring during the pull, is consumed
+	if err := jsonmessage.DisplayJSONMessagesStream(progress, io.Discard, 0, false, nil); err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", imageRef, err)
+	}
+	return nil
+}
+
+// LoadImage loads an image archive and returns the loaded image reference
+func (p *providerBackend) LoadImage(ctx context.Context, archive io.Reader) (string, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer cli.Close()
+
+	resp, err := cli.ImageLoad(ctx, archive, true)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return parseLoadedImageRef(resp.Body)
+}
+
+// PruneVolumes removes the unused volumes carrying the label
+func (p *providerBackend) PruneVolumes(ctx context.Context, label string) ([]string, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	report, err := cli.VolumesPrune(ctx, filters.NewArgs(filters.Arg("label", label)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune volumes: %w", err)
+	}
+
+	return report.VolumesDeleted, nil
+}
+
+// Info describes the Docker daemon
+func (p *providerBackend) Info(ctx context.Context) (RuntimeInfo, error) {
+	cli, err := newDockerClient(ctx)
+	if err != nil {
+		return RuntimeInfo{}, err
+	}
+	defer cli.Close()
+
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return RuntimeInfo{}, fmt.Errorf("failed to get docker daemon info: %w", err)
+	}
+
+	runtimes := make([]string, 0, len(info.Runtimes))
+	for name := range info.Runtimes {
+		runtimes = append(runtimes, name)
+	}
+	return RuntimeInfo{
+		Architecture: info.Architecture,
+		Runtimes:     runtimes,
+	}, nil
+}
+
+// newDockerClient creates a Docker client using the testcontainers configuration
+func newDockerClient(ctx context.Context) (*testcontainers.DockerClient, error) {
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+	return cli, nil
+}
@@ -0,0 +1,76 @@
+package docker
+
+import (
+	"sort"
+	"sync"
+)
+
+// ManagedContainerInfo describes a container the testkit created in this process
+type ManagedContainerInfo struct {
+	ID      string
+	Name    string
+	Image   string
+	Kind    string
+	Running bool
+}
+
+var (
+	registryMutex sync.Mutex
+	// registry holds the containers constructed, or started again, and not yet
+	// stopped or terminated, in registration order
+	registry    = make(map[*DockerContainer]uint64)
+	registrySeq uint64
+)
+
+// register records a container that was constructed or started
+func register(d *DockerContainer) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, ok := registry[d]; ok {
+		return
+	}
+	registrySeq++
+	registry[d] = registrySeq
+}
+
+// deregister forgets a container that was stopped or removed
+func deregister(d *DockerContainer) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	delete(registry, d)
+}
+
+// ManagedContainers returns the containers constructed by the testkit in this
+// process that have not been stopped or terminated, in registration order.
+// Containers not started yet are included; a stopped container is listed
+// again once it is restarted.
+func ManagedContainers() []ManagedContainerInfo {
+	registryMutex.Lock()
+	containers := make([]*DockerContainer, 0, len(registry))
+	for d := range registry {
+		containers = append(containers, d)
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		return registry[containers[i]] < registry[containers[j]]
+	})
+	registryMutex.Unlock()
+
+	// Query the running state outside the lock, as it calls the daemon
+	infos := make([]ManagedContainerInfo, len(containers))
+	for i, d := range containers {
+		kind := d.config.Kind
+		if kind == "" {
+			kind = "container"
+		}
+		infos[i] = ManagedContainerInfo{
+			ID:      d.ID(),
+			Name:    d.Name(),
+			Image:   d.Image(),
+			Kind:    kind,
+			Running: d.IsRunning(),
+		}
+	}
+	return infos
+}
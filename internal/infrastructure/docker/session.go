@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// sessionID identifies the containers created by this process
+var sessionID = fmt.Sprintf("%d-%d", os.Getpid(), time.Now().UnixNano())
+
+// SessionID returns the ID recorded in SessionLabel of every container created
+// by this process
+func SessionID() string {
+	return sessionID
+}
+
+// RemoveSessionContainers force-removes every container created by this
+// process, along with its anonymous volumes, and returns the IDs of the
+// removed containers. Removal continues past individual failures.
+func RemoveSessionContainers(ctx context.Context) ([]string, error) {
+	backend := Backend()
+
+	ids, err := backend.ListContainers(ctx, SessionLabel+"="+SessionID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session containers: %w", err)
+	}
+
+	removed := make([]string, 0, len(ids))
+	var firstErr error
+	for _, id := range ids {
+		if err := backend.RemoveContainer(ctx, id, true); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		removed = append(removed, id)
+	}
+
+	return removed, firstErr
+}
+
+var signalCleanupOnce sync.Once
+
+// InstallSignalCleanup traps SIGINT and SIGTERM and, on the first one received,
+// removes every container created by this process before exiting with
+// status 1. Installing it more than once has no further effect.
+func InstallSignalCleanup() {
+	signalCleanupOnce.Do(func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+		go func() {
+			sig := <-signals
+			// A second signal restores the default behaviour, killing the
+			// process if the cleanup hangs
+			signal.Reset(os.Interrupt, syscall.SIGTERM)
+
+			ctx, cancel := CleanupContext(context.Background())
+			removed, err := RemoveSessionContainers(ctx)
+			cancel()
+
+			fmt.Fprintf(os.Stderr, "skeleton-testkit: received %s, removed %d containers\n", sig, len(removed))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "skeleton-testkit: %v\n", err)
+			}
+			os.Exit(1)
+		}()
+	})
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package docker
+
+// socketGroup reports no group on platforms without Unix file ownership
+func socketGroup(path string) (string, bool) {
+	return "", false
+}
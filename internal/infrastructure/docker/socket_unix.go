@@ -0,0 +1,24 @@
+//go:build unix
+
+package docker
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// socketGroup returns the ID of the group owning the socket at path, which a
+// non-root container user must belong to in order to connect to it
+func socketGroup(path string) (string, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", false
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", false
+	}
+	return strconv.FormatUint(uint64(stat.Gid), 10), true
+}
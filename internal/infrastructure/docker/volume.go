@@ -0,0 +1,18 @@
+package docker
+
+import (
+	"context"
+)
+
+// RemoveContainer force-removes a container, optionally along with its
+// anonymous volumes
+func RemoveContainer(ctx context.Context, containerID string, removeVolumes bool) error {
+	return Backend().RemoveContainer(ctx, containerID, removeVolumes)
+}
+
+// PruneVolumes removes all unused volumes carrying the testkit's managed label,
+// which ManagedRequest puts on the volumes declared by container images, and
+// returns the names of the removed volumes
+func PruneVolumes(ctx context.Context) ([]string, error) {
+	return Backend().PruneVolumes(ctx, ManagedLabel)
+}
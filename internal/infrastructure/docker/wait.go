@@ -0,0 +1,224 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// logQuietPollInterval is how often the log quiet strategy checks the time of
+// the last output
+const logQuietPollInterval = 250 * time.Millisecond
+
+// logQuietStrategy considers a container ready once it has written no output
+// for the quiet period
+type logQuietStrategy struct {
+	container   *DockerContainer
+	quietPeriod time.Duration
+	timeout     time.Duration
+}
+
+// WaitUntilReady follows the output written since the container started,
+// recording when it last grew, until it stays unchanged for the quiet period,
+// failing if the container stops or the timeout expires
+func (s *logQuietStrategy) WaitUntilReady(ctx context.Context, target wait.StrategyTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	state, err := target.State(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get container state: %w", err)
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, state.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to parse container start time %q: %w", state.StartedAt, err)
+	}
+
+	logs, err := Backend().ContainerLogs(ctx, s.container.container.GetContainerID(), LogOptions{
+		Since:  startedAt,
+		Follow: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	activity := newActivityWriter()
+	copied := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(activity, activity, logs)
+		copied <- err
+	}()
+
+	ticker := time.NewTicker(logQuietPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-copied:
+			// The output stream ends when the container stops
+			if err != nil {
+				return fmt.Errorf("failed to read container logs: %w", err)
+			}
+			state, err := target.State(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get container state: %w", err)
+			}
+			return fmt.Errorf("container exited with code %d before its logs went quiet", state.ExitCode)
+		case <-ctx.Done():
+			return fmt.Errorf("logs did not stay quiet for %s within %s: %w", s.quietPeriod, s.timeout, ctx.Err())
+		case <-ticker.C:
+			if time.Since(activity.last()) >= s.quietPeriod {
+				return nil
+			}
+		}
+	}
+}
+
+// activityWriter records the time of the last data written to it
+type activityWriter struct {
+	mutex     sync.Mutex
+	writtenAt time.Time
+}
+
+// newActivityWriter creates a writer whose last activity is now
+func newActivityWriter() *activityWriter {
+	return &activityWriter{writtenAt: time.Now()}
+}
+
+// Write records the time of the write
+func (w *activityWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.writtenAt = time.Now()
+	return len(p), nil
+}
+
+// last returns the time of the last write
+func (w *activityWriter) last() time.Time {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.writtenAt
+}
+
+// startLogStrategy waits for a log line to be written a number of times since
+// the container was last started. Docker keeps the output of a container
+// across restarts, so counting from the start of the log would let lines
+// written before a restart satisfy the wait immediately.
+type startLogStrategy struct {
+	container         *DockerContainer
+	log               string
+	occurrence        int
+	restartOccurrence int
+	timeout           time.Duration
+}
+
+// ForLogSinceStart returns a readiness strategy waiting, for at most timeout,
+// for the log line to be written occurrence times after the first start of the
+// container and restartOccurrence times after each later start
+func (d *DockerContainer) ForLogSinceStart(log string, occurrence, restartOccurrence int, timeout time.Duration) wait.Strategy {
+	return &startLogStrategy{
+		container:         d,
+		log:               log,
+		occurrence:        occurrence,
+		restartOccurrence: restartOccurrence,
+		timeout:           timeout,
+	}
+}
+
+// WaitUntilReady follows the output written since the container started until
+// the log line has occurred often enough, failing if the container stops or
+// the timeout expires
+func (s *startLogStrategy) WaitUntilReady(ctx context.Context, target wait.StrategyTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// The start time is taken from the runtime so that it is comparable with
+	// the log timestamps regardless of clock drift between the hosts
+	state, err := target.State(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get container state: %w", err)
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, state.StartedAt)
+	if err != nil {
+		return fmt.Errorf("failed to parse container start time %q: %w", state.StartedAt, err)
+	}
+
+	occurrence := s.occurrence
+	if s.container.starts > 0 {
+		occurrence = s.restartOccurrence
+	}
+
+	logs, err := Backend().ContainerLogs(ctx, s.container.container.GetContainerID(), LogOptions{
+		Since:  startedAt,
+		Follow: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	counter := newOccurrenceWriter(s.log, occurrence)
+	copied := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(counter, counter, logs)
+		copied <- err
+	}()
+
+	select {
+	case <-counter.found:
+		return nil
+	case err := <-copied:
+		if err != nil {
+			return fmt.Errorf("failed to read container logs: %w", err)
+		}
+		return fmt.Errorf("container stopped before logging %q %d times", s.log, occurrence)
+	case <-ctx.Done():
+		return fmt.Errorf("container did not log %q %d times within %s: %w", s.log, occurrence, s.timeout, ctx.Err())
+	}
+}
+
+// occurrenceWriter counts the occurrences of a string in the data written to
+// it, closing found once the wanted count is reached
+type occurrenceWriter struct {
+	text  string
+	want  int
+	count int
+	// tail is the end of the data written so far that may begin an
+	// occurrence completed by the next write
+	tail  string
+	found chan struct{}
+}
+
+// newOccurrenceWriter creates a writer waiting for want occurrences of text
+func newOccurrenceWriter(text string, want int) *occurrenceWriter {
+	return &occurrenceWriter{
+		text:  text,
+		want:  want,
+		found: make(chan struct{}),
+	}
+}
+
+// Write counts the occurrences completed by p
+func (w *occurrenceWriter) Write(p []byte) (int, error) {
+	if w.count >= w.want {
+		return len(p), nil
+	}
+
+	data := w.tail + string(p)
+	w.count += strings.Count(data, w.text)
+	keep := min(len(w.text)-1, len(data))
+	w.tail = data[len(data)-keep:]
+
+	if w.count >= w.want {
+		close(w.found)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOccurrenceWriter(t *testing.T) {
+	const ready = "ready to accept connections"
+
+	tests := []struct {
+		name   string
+		want   int
+		writes []string
+		found  bool
+	}{
+		{
+			name:   "single write",
+			want:   1,
+			writes: []string{"LOG: database system is ready to accept connections\n"},
+			found:  true,
+		},
+		{
+			name:   "occurrence split across writes",
+			want:   1,
+			writes: []string{"LOG: database system is ready to ac", "cept connections\n"},
+			found:  true,
+		},
+		{
+			name:   "too few occurrences",
+			want:   2,
+			writes: []string{"ready to accept connections\n", "shutting down\n"},
+		},
+		{
+			name:   "occurrences across writes are counted",
+			want:   2,
+			writes: []string{"ready to accept connections\n", "restarting\n", "ready to accept connections\n"},
+			found:  true,
+		},
+		{
+			name:   "no match",
+			want:   1,
+			writes: []string{"starting\n", "ready to\n", " accept connections\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newOccurrenceWriter(ready, tt.want)
+			for _, data := range tt.writes {
+				n, err := w.Write([]byte(data))
+				require.NoError(t, err)
+				require.Equal(t, len(data), n)
+			}
+
+			select {
+			case <-w.found:
+				require.True(t, tt.found, "occurrences found unexpectedly")
+			default:
+				require.False(t, tt.found, "occurrences not found")
+			}
+		})
+	}
+}
+
+func TestOccurrenceWriterAfterFound(t *testing.T) {
+	w := newOccurrenceWriter("ready", 1)
+
+	_, err := w.Write([]byte("ready\n"))
+	require.NoError(t, err)
+	// Further writes must not close found again
+	_, err = w.Write([]byte("ready\n"))
+	require.NoError(t, err)
+}
@@ -1,12 +1,18 @@
 package testcontainers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/docker/go-connections/nat"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 
@@ -18,16 +24,150 @@ import (
 type TestcontainerAppContainer struct {
 	*docker.DockerContainer
 	skeletonConfig *container.SkeletonConfig
+	contract       container.SkeletonContract
 	dependencies   []container.Container
+	httpReadiness  bool
+	pluginPrefix   string
+	injection      container.SkeletonConfigInjection
+	dependencyEnv  map[string]container.Container
+	healthGates    []dependencyHealthGate
+	inheritEnv     []string
+	overrides      []pluginConfigOverride
+	stopOrder      []container.StopRecord
+	startup        container.StartupMetrics
+	aliases        map[string]container.Container
+	configEnvKey   string
+	serviceEnvKey  string
+}
+
+// pluginConfigOverride sets a single value of a plugin's configuration when the
+// container is created
+type pluginConfigOverride struct {
+	plugin string
+	key    string
+	value  interface{}
+}
+
+// dependencyHealthGate gates readiness on the application health endpoint at
+// path reporting the named dependency as connected
+type dependencyHealthGate struct {
+	name string
+	path string
 }
 
 // NewTestcontainerAppContainer creates a new TestcontainerAppContainer
 func NewTestcontainerAppContainer(config *docker.ContainerConfig, skeletonConfig *container.SkeletonConfig) *TestcontainerAppContainer {
+	if config.Kind == "" {
+		config.Kind = "app"
+	}
 	return &TestcontainerAppContainer{
 		DockerContainer: docker.NewDockerContainer(config),
 		skeletonConfig:  skeletonConfig,
+		contract:        container.DefaultSkeletonContract(),
+		configEnvKey:    "SKELETON_CONFIG",
+		serviceEnvKey:   "SKELETON_SERVICE_ID",
 		dependencies:    make([]container.Container, 0),
+		httpReadiness:   true,
+	}
+}
+
+// SetSkeletonConfig sets the skeleton configuration
+func (t *TestcontainerAppContainer) SetSkeletonConfig(skeletonConfig *container.SkeletonConfig) {
+	t.skeletonConfig = skeletonConfig
+}
+
+// SetSkeletonContract overrides the ports and endpoint paths the application exposes.
+// Zero-valued fields of the contract keep their current values.
+func (t *TestcontainerAppContainer) SetSkeletonContract(contract container.SkeletonContract) {
+	t.contract = t.contract.Merge(contract)
+}
+
+// SkeletonContract returns the ports and endpoint paths the application exposes
+func (t *TestcontainerAppContainer) SkeletonContract() container.SkeletonContract {
+	return t.contract
+}
+
+// SetHTTPReadiness sets whether readiness also requires the health endpoint to
+// respond with HTTP 200, rather than only the HTTP port to be listening
+func (t *TestcontainerAppContainer) SetHTTPReadiness(enabled bool) {
+	t.httpReadiness = enabled
+}
+
+// SetPluginEnvPrefix enables flattening each plugin's configuration into
+// environment variables named <PREFIX>_<PLUGIN>_CONFIG_<KEY>, in addition to
+// the SKELETON_CONFIG JSON. An empty prefix disables flattening.
+func (t *TestcontainerAppContainer) SetPluginEnvPrefix(prefix string) {
+	t.pluginPrefix = prefix
+}
+
+// SetSkeletonConfigInjection sets how the skeleton configuration is passed to
+// the application environment
+func (t *TestcontainerAppContainer) SetSkeletonConfigInjection(injection container.SkeletonConfigInjection) {
+	t.injection = injection
+}
+
+// SetSkeletonConfigEnvKey sets the environment variable the skeleton
+// configuration JSON is injected as, SKELETON_CONFIG by default
+func (t *TestcontainerAppContainer) SetSkeletonConfigEnvKey(key string) {
+	t.configEnvKey = key
+}
+
+// SetServiceIDEnvKey sets the environment variable the service ID is injected
+// as, SKELETON_SERVICE_ID by default
+func (t *TestcontainerAppContainer) SetServiceIDEnvKey(key string) {
+	t.serviceEnvKey = key
+}
+
+// SetDependencyEnv injects the connection string of a dependency into the
+// environment variable name, resolved when the container is created, after
+// the dependency is healthy
+func (t *TestcontainerAppContainer) SetDependencyEnv(name string, dep container.Container) {
+	if t.dependencyEnv == nil {
+		t.dependencyEnv = make(map[string]container.Container)
 	}
+	t.dependencyEnv[name] = dep
+}
+
+// dependencyConnectionString returns the connection string the application
+// uses to reach a dependency: the in-network one if the dependency shares a
+// network with the application, the host one otherwise
+func dependencyConnectionString(dep container.Container) string {
+	if internal, ok := dep.(interface{ InternalConnectionString() string }); ok {
+		if connStr := internal.InternalConnectionString(); connStr != "" {
+			return connStr
+		}
+	}
+	return dep.ConnectionString()
+}
+
+// dependencyReadyTimeout returns how long a dependency may take to become
+// ready: its own readiness timeout, or the package-wide default for
+// dependencies without one
+func dependencyReadyTimeout(dep container.Container) time.Duration {
+	if timed, ok := dep.(interface{ ReadyTimeout() time.Duration }); ok {
+		return timed.ReadyTimeout()
+	}
+	return docker.DefaultReadyTimeout()
+}
+
+// AddInheritEnv passes the named variables of the test process environment
+// through to the container, read when the container is created
+func (t *TestcontainerAppContainer) AddInheritEnv(keys ...string) {
+	t.inheritEnv = append(t.inheritEnv, keys...)
+}
+
+// AddPluginConfigOverride overrides a value of the named plugin's
+// configuration when the container is created, leaving the skeleton
+// configuration itself untouched. Dots in key address nested objects;
+// object values are merged into existing objects rather than replacing them.
+func (t *TestcontainerAppContainer) AddPluginConfigOverride(plugin, key string, value interface{}) {
+	t.overrides = append(t.overrides, pluginConfigOverride{plugin: plugin, key: key, value: value})
+}
+
+// AddDependencyHealthGate gates the application's readiness on its health
+// endpoint at path reporting the named dependency as connected
+func (t *TestcontainerAppContainer) AddDependencyHealthGate(name, path string) {
+	t.healthGates = append(t.healthGates, dependencyHealthGate{name: name, path: path})
 }
 
 // AddDependency adds a container dependency
@@ -37,85 +177,362 @@ func (t *TestcontainerAppContainer) AddDependency(dep container.Container) {
 
 // Start starts the container and its dependencies
 func (t *TestcontainerAppContainer) Start(ctx context.Context) error {
-	// Start dependencies first
+	t.startup = container.StartupMetrics{}
+	began := time.Now()
+	defer func() { t.startup.Total = time.Since(began) }()
+
+	// Bring dependencies up first, so that their connection strings are only
+	// resolved once they are healthy
 	for _, dep := range t.dependencies {
-		if !dep.IsRunning() {
-			if err := dep.Start(ctx); err != nil {
-				return &container.ContainerError{
-					Operation: "start_dependency",
-					Container: t.ID(),
-					Message:   fmt.Sprintf("failed to start dependency %s", dep.ID()),
-					Cause:     err,
-				}
-			}
+		if err := t.startDependency(ctx, dep); err != nil {
+			return err
 		}
 	}
+	t.startup.Dependencies = time.Since(began)
 
-	// Create the testcontainer
-	if err := t.createContainer(ctx); err != nil {
-		return err
+	// Create the testcontainer, reusing it if it was already created.
+	// Dependency URLs are resolved into the environment at creation.
+	if t.Container() == nil {
+		created := time.Now()
+		err := t.createContainer(ctx)
+		t.startup.Pull = time.Since(created)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Start the container
-	return t.DockerContainer.Start(ctx)
+	started := time.Now()
+	defer func() { t.startup.Readiness = time.Since(started) }()
+	if err := t.DockerContainer.Start(ctx); err != nil {
+		return err
+	}
+
+	return t.waitForDependencyHealth(ctx, t.Config().ReadinessTimeout())
 }
 
-// createContainer creates the underlying testcontainer
-func (t *TestcontainerAppContainer) createContainer(ctx context.Context) error {
+// StartupMetrics returns the phase timings of the last Start, including a
+// failed one up to the phase that failed
+func (t *TestcontainerAppContainer) StartupMetrics() container.StartupMetrics {
+	return t.startup
+}
+
+// pluginEnv flattens the configuration of each plugin into environment
+// variables named <PREFIX>_<PLUGIN>_CONFIG_<KEY>. Nested objects extend the
+// key with their own keys; non-string values are JSON encoded.
+func pluginEnv(prefix string, plugins []container.SkeletonPluginConfig) map[string]string {
+	env := make(map[string]string)
+	for _, plugin := range plugins {
+		flattenPluginConfig(env, envName(prefix, plugin.Name, "CONFIG"), plugin.Config)
+	}
+	return env
+}
+
+// flattenPluginConfig adds the values of a plugin configuration to env under the given key
+func flattenPluginConfig(env map[string]string, key string, config map[string]interface{}) {
+	for name, value := range config {
+		valueKey := envName(key, name)
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flattenPluginConfig(env, valueKey, v)
+		case string:
+			env[valueKey] = v
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			env[valueKey] = string(encoded)
+		}
+	}
+}
+
+// envName joins the parts into an upper-case environment variable name,
+// replacing characters that are not letters or digits with underscores
+func envName(parts ...string) string {
+	name := strings.ToUpper(strings.Join(parts, "_"))
+	return strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// readinessStrategy returns the default readiness strategy: the HTTP port must
+// be listening and, unless disabled, the health endpoint must respond with 200,
+// since an application can listen before its routes are mounted
+func (t *TestcontainerAppContainer) readinessStrategy(httpPort nat.Port) wait.Strategy {
+	timeout := t.Config().ReadinessTimeout()
+
+	portReady := wait.ForListeningPort(httpPort).WithStartupTimeout(timeout)
+	if !t.httpReadiness || t.contract.HealthPath == "" {
+		return portReady
+	}
+
+	return wait.ForAll(
+		portReady,
+		wait.ForHTTP(t.contract.HealthPath).
+			WithPort(httpPort).
+			WithStatusCodeMatcher(func(status int) bool { return status == http.StatusOK }).
+			WithStartupTimeout(timeout),
+	).WithDeadline(timeout)
+}
+
+// ResolvedEnvironment returns the environment passed to the container: the
+// configured environment combined with the variables derived from the skeleton
+// configuration
+func (t *TestcontainerAppContainer) ResolvedEnvironment() (map[string]string, error) {
 	config := t.Config()
 
-	// Build environment variables
+	// Explicitly configured variables override the fake clock ones
 	env := make(map[string]string)
+	for k, v := range config.FakeClockEnvironment() {
+		env[k] = v
+	}
 	for k, v := range config.Environment {
 		env[k] = v
 	}
 
+	skeletonConfig, err := t.resolvedSkeletonConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	// Add skeleton-specific environment variables
-	if t.skeletonConfig != nil {
+	if skeletonConfig != nil {
 		// Serialize complete skeleton config as JSON
-		skeletonConfigJSON, err := json.Marshal(t.skeletonConfig)
-		if err != nil {
+		if t.injection != container.InjectFieldsOnly {
+			skeletonConfigJSON, err := json.Marshal(skeletonConfig)
+			if err != nil {
+				return nil, &container.ContainerError{
+					Operation: "serialize_skeleton_config",
+					Container: t.ID(),
+					Message:   "failed to serialize skeleton configuration",
+					Cause:     err,
+				}
+			}
+			env[t.configEnvKey] = string(skeletonConfigJSON)
+		}
+
+		// Keep backward compatibility with individual fields
+		if t.injection != container.InjectJSONOnly {
+			if skeletonConfig.ServiceID != "" {
+				env[t.serviceEnvKey] = skeletonConfig.ServiceID
+			}
+			if skeletonConfig.Storage.Type != "" {
+				env["SKELETON_STORAGE_TYPE"] = skeletonConfig.Storage.Type
+			}
+			if skeletonConfig.Storage.URL != "" {
+				env["SKELETON_STORAGE_URL"] = skeletonConfig.Storage.URL
+			}
+		}
+
+		// Flatten plugin configuration for plugins reading individual variables,
+		// without overriding explicitly configured environment variables
+		if t.pluginPrefix != "" {
+			for k, v := range pluginEnv(t.pluginPrefix, skeletonConfig.Plugins) {
+				if _, ok := config.Environment[k]; !ok {
+					env[k] = v
+				}
+			}
+		}
+	}
+
+	// Pass through host variables, skipping unset ones and without overriding
+	// explicitly configured environment variables
+	for _, key := range t.inheritEnv {
+		if _, ok := config.Environment[key]; ok {
+			continue
+		}
+		if value, ok := os.LookupEnv(key); ok {
+			env[key] = value
+		}
+	}
+
+	// Resolve dependency connection strings, which are only known once the
+	// dependencies have started
+	for name, dep := range t.dependencyEnv {
+		if _, ok := config.Environment[name]; !ok {
+			env[name] = dependencyConnectionString(dep)
+		}
+	}
+
+	return env, nil
+}
+
+// resolvedSkeletonConfig returns the skeleton configuration with the plugin
+// configuration overrides applied to a copy, so that a base configuration can
+// be shared across containers
+func (t *TestcontainerAppContainer) resolvedSkeletonConfig() (*container.SkeletonConfig, error) {
+	if len(t.overrides) == 0 {
+		return t.skeletonConfig, nil
+	}
+	if t.skeletonConfig == nil {
+		var errs container.ConfigErrors
+		for _, override := range t.overrides {
+			errs.Add(overrideField(override), "cannot be overridden without a skeleton configuration")
+		}
+		return nil, t.configError(errs)
+	}
+
+	resolved := *t.skeletonConfig
+	resolved.Plugins = make([]container.SkeletonPluginConfig, len(t.skeletonConfig.Plugins))
+	for i, plugin := range t.skeletonConfig.Plugins {
+		plugin.Config = copyConfigMap(plugin.Config)
+		resolved.Plugins[i] = plugin
+	}
+
+	var errs container.ConfigErrors
+	for _, override := range t.overrides {
+		index := -1
+		for i, plugin := range resolved.Plugins {
+			if plugin.Name == override.plugin {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			errs.Add(overrideField(override), fmt.Sprintf("overrides unknown plugin %s", override.plugin))
+			continue
+		}
+
+		plugin := &resolved.Plugins[index]
+		if plugin.Config == nil {
+			plugin.Config = make(map[string]interface{})
+		}
+		setConfigValue(plugin.Config, strings.Split(override.key, "."), override.value)
+	}
+	if len(errs) > 0 {
+		return nil, t.configError(errs)
+	}
+
+	return &resolved, nil
+}
+
+// overrideField returns the configuration field path set by an override
+func overrideField(override pluginConfigOverride) string {
+	return fmt.Sprintf("plugins.%s.config.%s", override.plugin, override.key)
+}
+
+// configError wraps configuration errors of the container, which remain
+// available to errors.As
+func (t *TestcontainerAppContainer) configError(errs error) error {
+	return &container.ContainerError{
+		Operation: "resolve_skeleton_config",
+		Container: t.ID(),
+		Message:   "invalid skeleton configuration",
+		Cause:     errs,
+	}
+}
+
+// ValidateConfig checks the skeleton configuration, with the plugin
+// configuration overrides applied, without starting anything. The returned
+// error wraps ConfigErrors listing every invalid field.
+func (t *TestcontainerAppContainer) ValidateConfig() error {
+	resolved, err := t.resolvedSkeletonConfig()
+	if err != nil {
+		return err
+	}
+	if resolved == nil {
+		return nil
+	}
+
+	if err := resolved.Validate(); err != nil {
+		return t.configError(err)
+	}
+	return nil
+}
+
+// copyConfigMap returns a deep copy of the nested objects of a configuration map
+func copyConfigMap(config map[string]interface{}) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	copied := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = copyConfigMap(nested)
+		}
+		copied[key] = value
+	}
+	return copied
+}
+
+// setConfigValue sets the value at path in config, creating intermediate
+// objects as needed and merging object values into existing objects
+func setConfigValue(config map[string]interface{}, path []string, value interface{}) {
+	key := path[0]
+	if len(path) > 1 {
+		nested, ok := config[key].(map[string]interface{})
+		if !ok {
+			nested = make(map[string]interface{})
+			config[key] = nested
+		}
+		setConfigValue(nested, path[1:], value)
+		return
+	}
+
+	existing, existingOK := config[key].(map[string]interface{})
+	override, overrideOK := value.(map[string]interface{})
+	if !existingOK || !overrideOK {
+		if overrideOK {
+			override = copyConfigMap(override)
+			value = override
+		}
+		config[key] = value
+		return
+	}
+
+	for nestedKey, nestedValue := range override {
+		setConfigValue(existing, []string{nestedKey}, nestedValue)
+	}
+}
+
+// startDependency starts a dependency, waits for it to be ready within its own
+// readiness timeout and verifies its health check
+func (t *TestcontainerAppContainer) startDependency(ctx context.Context, dep container.Container) error {
+	if !dep.IsRunning() {
+		if err := dep.Start(ctx); err != nil {
 			return &container.ContainerError{
-				Operation: "serialize_skeleton_config",
+				Operation: "start_dependency",
 				Container: t.ID(),
-				Message:   "failed to serialize skeleton configuration",
+				Message:   fmt.Sprintf("failed to start dependency %s", dep.ID()),
 				Cause:     err,
 			}
 		}
-		env["SKELETON_CONFIG"] = string(skeletonConfigJSON)
+	}
 
-		// Keep backward compatibility with individual fields
-		if t.skeletonConfig.ServiceID != "" {
-			env["SKELETON_SERVICE_ID"] = t.skeletonConfig.ServiceID
-		}
-		if t.skeletonConfig.Storage.Type != "" {
-			env["SKELETON_STORAGE_TYPE"] = t.skeletonConfig.Storage.Type
+	if err := dep.WaitForReady(ctx, dependencyReadyTimeout(dep)); err != nil {
+		return &container.ContainerError{
+			Operation: "start_dependency",
+			Container: t.ID(),
+			Message:   fmt.Sprintf("dependency %s did not become ready", dep.ID()),
+			Cause:     err,
 		}
-		if t.skeletonConfig.Storage.URL != "" {
-			env["SKELETON_STORAGE_URL"] = t.skeletonConfig.Storage.URL
+	}
+
+	if err := dep.HealthCheck(ctx); err != nil {
+		return &container.ContainerError{
+			Operation: "start_dependency",
+			Container: t.ID(),
+			Message:   fmt.Sprintf("dependency %s is not healthy", dep.ID()),
+			Cause:     err,
 		}
 	}
 
-	// Build exposed ports
-	exposedPorts := make([]string, 0)
-	for _, port := range config.Ports {
-		exposedPorts = append(exposedPorts, fmt.Sprintf("%d/tcp", port.Internal))
+	return nil
+}
+
+// createContainer creates the underlying testcontainer
+func (t *TestcontainerAppContainer) createContainer(ctx context.Context) error {
+	req, err := t.BuildRequest()
+	if err != nil {
+		return err
 	}
 
-	// Create container request
-	req := testcontainers.ContainerRequest{
-		Image:        config.Image,
-		Name:         config.Name,
-		Env:          env,
-		ExposedPorts: exposedPorts,
-		WaitingFor:   wait.ForListeningPort("8080/tcp").WithStartupTimeout(30 * time.Second),
-	}
-
-	// Create the container
-	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          false, // We'll start it manually
-	})
+	c, err := docker.CreateContainer(ctx, req)
 	if err != nil {
 		return &container.ContainerError{
 			Operation: "create",
@@ -129,46 +546,202 @@ func (t *TestcontainerAppContainer) createContainer(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the container
+// BuildRequest computes the request creating the container, without
+// contacting Docker. Dependency connection strings are resolved from the
+// current state of the dependencies.
+func (t *TestcontainerAppContainer) BuildRequest() (testcontainers.ContainerRequest, error) {
+	config := t.Config()
+
+	if err := config.ValidateVolumes(); err != nil {
+		return testcontainers.ContainerRequest{}, &container.ContainerError{
+			Operation: "create",
+			Container: t.ID(),
+			Message:   "invalid container configuration",
+			Cause:     err,
+		}
+	}
+
+	env, err := t.ResolvedEnvironment()
+	if err != nil {
+		return testcontainers.ContainerRequest{}, err
+	}
+
+	// Build exposed ports, always including the application's HTTP port
+	httpPort := fmt.Sprintf("%d/tcp", t.contract.HTTPPort)
+	exposedPorts := []string{httpPort}
+	for _, port := range config.Ports {
+		if port.Internal != t.contract.HTTPPort {
+			exposedPorts = append(exposedPorts, fmt.Sprintf("%d/tcp", port.Internal))
+		}
+	}
+
+	// Create container request
+	req := testcontainers.ContainerRequest{
+		Image:              config.Image,
+		ImagePlatform:      config.Platform,
+		Name:               config.Name,
+		Env:                env,
+		ExposedPorts:       exposedPorts,
+		WaitingFor:         config.WaitStrategy(t.readinessStrategy(nat.Port(httpPort))),
+		Networks:           config.Networks,
+		NetworkAliases:     config.NetworkAliases,
+		ConfigModifier:     config.ConfigModifier(),
+		HostConfigModifier: config.HostConfigModifier(),
+		Files:              config.InitScriptFiles(),
+	}
+
+	// Build the image from a Dockerfile if configured
+	if config.Build != nil {
+		build, err := config.Build.FromDockerfile()
+		if err != nil {
+			return testcontainers.ContainerRequest{}, &container.ContainerError{
+				Operation: "build",
+				Container: t.ID(),
+				Message:   "failed to prepare image build",
+				Cause:     err,
+			}
+		}
+		req.FromDockerfile = build
+	}
+
+	return req, nil
+}
+
+// ValidateImages checks, before anything is started, that the image of the
+// container and of every dependency exists locally or in its registry,
+// collecting every missing image. An image built from a Dockerfile is not
+// checked.
+func (t *TestcontainerAppContainer) ValidateImages(ctx context.Context) error {
+	errs := container.NewMultiError()
+	checked := make(map[string]bool)
+
+	check := func(id, image string) {
+		if image == "" || checked[image] {
+			return
+		}
+		checked[image] = true
+
+		if err := docker.CheckImageAvailable(ctx, image); err != nil {
+			errs.Add(&container.ContainerError{
+				Operation: "validate_image",
+				Container: id,
+				Message:   fmt.Sprintf("image %s is not available", image),
+				Cause:     err,
+			})
+		}
+	}
+
+	if t.Config().Build == nil {
+		check(t.ID(), t.Image())
+	}
+	for _, dep := range t.dependencies {
+		check(dep.ID(), dep.Image())
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Stop stops the container and its dependencies, collecting every failure
 func (t *TestcontainerAppContainer) Stop(ctx context.Context) error {
+	errs := container.NewMultiError()
+
 	// Stop the main container first
 	if err := t.DockerContainer.Stop(ctx); err != nil {
-		return err
+		errs.Add(&container.ContainerError{
+			Operation: "stop",
+			Container: t.ID(),
+			Message:   "failed to stop application container",
+			Cause:     err,
+		})
 	}
 
-	// Stop dependencies in reverse order
+	// Stop dependencies in reverse order, continuing past failures and
+	// recording each stop
+	t.stopOrder = make([]container.StopRecord, 0, len(t.dependencies))
 	for i := len(t.dependencies) - 1; i >= 0; i-- {
 		dep := t.dependencies[i]
 		if dep.IsRunning() {
-			if err := dep.Stop(ctx); err != nil {
-				// Log error but continue stopping other dependencies
-				continue
+			err := dep.Stop(ctx)
+			t.stopOrder = append(t.stopOrder, container.StopRecord{
+				Name:      dep.Name(),
+				StoppedAt: time.Now(),
+				Err:       err,
+			})
+			if err != nil {
+				errs.Add(&container.ContainerError{
+					Operation: "stop_dependency",
+					Container: t.ID(),
+					Message:   fmt.Sprintf("failed to stop dependency %s", dep.ID()),
+					Cause:     err,
+				})
 			}
 		}
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
-// WaitForReady waits for the container and its dependencies to be ready
-func (t *TestcontainerAppContainer) WaitForReady(ctx context.Context, timeout time.Duration) error {
-	// Wait for dependencies first
-	for _, dep := range t.dependencies {
-		if err := dep.WaitForReady(ctx, timeout); err != nil {
-			return &container.ContainerError{
-				Operation: "wait_dependency",
+// StopOrder returns the dependencies stopped by the last Stop, in the order
+// they were stopped; dependencies that were not running are omitted
+func (t *TestcontainerAppContainer) StopOrder() []container.StopRecord {
+	return append([]container.StopRecord(nil), t.stopOrder...)
+}
+
+// terminator is a container that can be removed along with its resources
+type terminator interface {
+	Terminate(ctx context.Context) error
+}
+
+// Terminate removes the container and then its dependencies, in reverse order,
+// continuing past failures
+func (t *TestcontainerAppContainer) Terminate(ctx context.Context) error {
+	errs := container.NewMultiError()
+
+	if err := t.DockerContainer.Terminate(ctx); err != nil {
+		errs.Add(&container.ContainerError{
+			Operation: "terminate",
+			Container: t.ID(),
+			Message:   "failed to terminate application container",
+			Cause:     err,
+		})
+	}
+
+	for i := len(t.dependencies) - 1; i >= 0; i-- {
+		dep, ok := t.dependencies[i].(terminator)
+		if !ok {
+			continue
+		}
+		if err := dep.Terminate(ctx); err != nil {
+			errs.Add(&container.ContainerError{
+				Operation: "terminate_dependency",
 				Container: t.ID(),
-				Message:   fmt.Sprintf("dependency %s not ready", dep.ID()),
+				Message:   fmt.Sprintf("failed to terminate dependency %s", t.dependencies[i].ID()),
 				Cause:     err,
-			}
+			})
 		}
 	}
 
+	return errs.ErrorOrNil()
+}
+
+// WaitForReady waits for the container and its dependencies to be ready.
+// Dependencies are waited for concurrently and every readiness failure is
+// reported before the main container is checked.
+func (t *TestcontainerAppContainer) WaitForReady(ctx context.Context, timeout time.Duration) error {
+	// Wait for dependencies first
+	if err := t.waitForDependencies(ctx, timeout); err != nil {
+		return err
+	}
+
 	// Wait for main container
 	if err := t.DockerContainer.WaitForReady(ctx, timeout); err != nil {
 		return err
 	}
 
+	if err := t.waitForDependencyHealth(ctx, timeout); err != nil {
+		return err
+	}
+
 	// Validate skeleton endpoints if this is a skeleton application
 	if t.skeletonConfig != nil {
 		if err := t.validateSkeletonEndpoints(ctx); err != nil {
@@ -184,6 +757,36 @@ func (t *TestcontainerAppContainer) WaitForReady(ctx context.Context, timeout ti
 	return nil
 }
 
+// waitForDependencies waits for all dependencies concurrently, collecting every readiness failure
+func (t *TestcontainerAppContainer) waitForDependencies(ctx context.Context, timeout time.Duration) error {
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+	errs := container.NewMultiError()
+
+	for _, dep := range t.dependencies {
+		wg.Add(1)
+		go func(dep container.Container) {
+			defer wg.Done()
+
+			if err := dep.WaitForReady(ctx, timeout); err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				errs.Add(&container.ContainerError{
+					Operation: "wait_dependency",
+					Container: t.ID(),
+					Message:   fmt.Sprintf("dependency %s not ready", dep.ID()),
+					Cause:     err,
+				})
+			}
+		}(dep)
+	}
+
+	wg.Wait()
+	return errs.ErrorOrNil()
+}
+
 // validateSkeletonEndpoints validates that skeleton-specific endpoints are accessible
 func (t *TestcontainerAppContainer) validateSkeletonEndpoints(ctx context.Context) error {
 	baseURL := t.ConnectionString()
@@ -193,14 +796,21 @@ func (t *TestcontainerAppContainer) validateSkeletonEndpoints(ctx context.Contex
 
 	client := &http.Client{Timeout: 10 * time.Second}
 
+	if t.contract.SystemHealthPath == "" {
+		return fmt.Errorf("system health endpoint not configured")
+	}
+	if t.contract.ComponentsPath == "" {
+		return fmt.Errorf("components endpoint not configured")
+	}
+
 	// Validate skeleton system service endpoint
-	systemURL := baseURL + "/api/system/health"
+	systemURL := baseURL + t.contract.SystemHealthPath
 	if err := t.validateEndpoint(ctx, client, systemURL, "skeleton system service"); err != nil {
 		return err
 	}
 
 	// Validate skeleton components endpoint
-	componentsURL := baseURL + "/api/components"
+	componentsURL := baseURL + t.contract.ComponentsPath
 	if err := t.validateEndpoint(ctx, client, componentsURL, "skeleton components"); err != nil {
 		return err
 	}
@@ -208,6 +818,132 @@ func (t *TestcontainerAppContainer) validateSkeletonEndpoints(ctx context.Contex
 	return nil
 }
 
+// waitForDependencyHealth polls the application health endpoints of the
+// dependency health gates until each reports its dependency as connected
+func (t *TestcontainerAppContainer) waitForDependencyHealth(ctx context.Context, timeout time.Duration) error {
+	if len(t.healthGates) == 0 {
+		return nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for _, gate := range t.healthGates {
+		for {
+			lastErr := t.checkDependencyHealth(timeoutCtx, client, gate)
+			if lastErr == nil {
+				break
+			}
+
+			select {
+			case <-timeoutCtx.Done():
+				return &container.ContainerError{
+					Operation: "wait_dependency_health",
+					Container: t.ID(),
+					Message:   fmt.Sprintf("timeout waiting for application to report dependency %s as connected", gate.name),
+					Cause:     lastErr,
+				}
+			case <-ticker.C:
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkDependencyHealth checks once whether the health endpoint of a gate
+// reports its dependency as connected
+func (t *TestcontainerAppContainer) checkDependencyHealth(ctx context.Context, client *http.Client, gate dependencyHealthGate) error {
+	baseURL := t.ConnectionString()
+	if baseURL == "" {
+		return fmt.Errorf("unable to get connection string for dependency health check")
+	}
+
+	url := baseURL + gate.path
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request for health endpoint %s: %w", url, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach health endpoint %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	var report interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return fmt.Errorf("failed to decode health endpoint %s response: %w", url, err)
+	}
+
+	status, found := dependencyStatus(report, gate.name)
+	if !found {
+		return fmt.Errorf("health endpoint %s does not report dependency %s", url, gate.name)
+	}
+	if !status {
+		return fmt.Errorf("health endpoint %s reports dependency %s as not connected", url, gate.name)
+	}
+
+	return nil
+}
+
+// dependencyReportKeys are the health report fields that commonly group
+// dependency statuses
+var dependencyReportKeys = []string{"checks", "dependencies", "components", "details"}
+
+// dependencyStatus looks up the named dependency in a decoded health report,
+// either at the top level or grouped under one of dependencyReportKeys, keyed
+// by name or listed as an entry with a matching "name" field. It reports
+// whether the dependency is connected and whether it was found at all.
+func dependencyStatus(report interface{}, name string) (bool, bool) {
+	switch report := report.(type) {
+	case map[string]interface{}:
+		if entry, ok := report[name]; ok {
+			return connectedStatus(entry), true
+		}
+		for _, key := range dependencyReportKeys {
+			if group, ok := report[key]; ok {
+				if connected, found := dependencyStatus(group, name); found {
+					return connected, true
+				}
+			}
+		}
+	case []interface{}:
+		for _, entry := range report {
+			if fields, ok := entry.(map[string]interface{}); ok && fields["name"] == name {
+				return connectedStatus(fields), true
+			}
+		}
+	}
+	return false, false
+}
+
+// connectedStatus interprets a dependency entry of a health report: a boolean,
+// a status string such as "up" or "connected", or an object carrying either
+func connectedStatus(entry interface{}) bool {
+	switch entry := entry.(type) {
+	case bool:
+		return entry
+	case string:
+		switch strings.ToLower(entry) {
+		case "up", "ok", "healthy", "connected", "pass", "passing":
+			return true
+		}
+		return false
+	case map[string]interface{}:
+		for _, key := range []string{"connected", "healthy", "status", "state"} {
+			if value, ok := entry[key]; ok {
+				return connectedStatus(value)
+			}
+		}
+	}
+	return false
+}
+
 // validateEndpoint validates that a specific endpoint is accessible
 func (t *TestcontainerAppContainer) validateEndpoint(ctx context.Context, client *http.Client, url, name string) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -222,12 +958,30 @@ func (t *TestcontainerAppContainer) validateEndpoint(ctx context.Context, client
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("%s endpoint returned status %d", name, resp.StatusCode)
+		return fmt.Errorf("%s endpoint %s returned status %d%s", name, url, resp.StatusCode, bodySnippet(resp.Body))
 	}
 
 	return nil
 }
 
+// maxBodySnippet bounds the response body included in endpoint validation errors
+const maxBodySnippet = 512
+
+// bodySnippet returns the beginning of a response body formatted for an
+// error message, or an empty string if the body is empty or unreadable
+func bodySnippet(body io.Reader) string {
+	content, err := io.ReadAll(io.LimitReader(body, maxBodySnippet+1))
+	if err != nil || len(bytes.TrimSpace(content)) == 0 {
+		return ""
+	}
+
+	snippet := strings.TrimSpace(string(content))
+	if len(content) > maxBodySnippet {
+		snippet = strings.TrimSpace(string(content[:maxBodySnippet])) + "..."
+	}
+	return fmt.Sprintf(": %q", snippet)
+}
+
 // SkeletonConfig returns the skeleton configuration
 func (t *TestcontainerAppContainer) SkeletonConfig() *container.SkeletonConfig {
 	return t.skeletonConfig
@@ -238,38 +992,54 @@ func (t *TestcontainerAppContainer) Dependencies() []container.Container {
 	return t.dependencies
 }
 
+// SetDependencyAlias names a dependency for lookup with Dependency, replacing
+// any dependency previously named alias
+func (t *TestcontainerAppContainer) SetDependencyAlias(alias string, dep container.Container) {
+	if t.aliases == nil {
+		t.aliases = make(map[string]container.Container)
+	}
+	t.aliases[alias] = dep
+}
+
+// Dependency returns the dependency named alias
+func (t *TestcontainerAppContainer) Dependency(alias string) (container.Container, error) {
+	dep, ok := t.aliases[alias]
+	if !ok {
+		return nil, &container.ContainerError{
+			Operation: "dependency",
+			Container: t.ID(),
+			Message:   fmt.Sprintf("no dependency named %q", alias),
+		}
+	}
+	return dep, nil
+}
+
+// SetDependencies replaces the container dependencies, which are started in order
+func (t *TestcontainerAppContainer) SetDependencies(deps []container.Container) {
+	t.dependencies = deps
+}
+
 // ConnectionString returns a connection string for the container
 func (t *TestcontainerAppContainer) ConnectionString() string {
 	host := t.Host()
-	port, err := t.Port(8080) // Default application port
+	port, err := t.Port(t.contract.HTTPPort)
 	if err != nil {
 		return ""
 	}
 	return fmt.Sprintf("http://%s:%d", host, port)
 }
 
-// HealthEndpoint returns the health check endpoint URL
+// HealthEndpoint returns the health check endpoint path
 func (t *TestcontainerAppContainer) HealthEndpoint() string {
-	if t.skeletonConfig != nil {
-		// Default health endpoint for skeleton applications
-		return "/health"
-	}
-	return "/health"
+	return t.contract.HealthPath
 }
 
-// MetricsEndpoint returns the metrics endpoint URL
+// MetricsEndpoint returns the metrics endpoint path
 func (t *TestcontainerAppContainer) MetricsEndpoint() string {
-	if t.skeletonConfig != nil {
-		// Default metrics endpoint for skeleton applications
-		return "/metrics"
-	}
-	return "/metrics"
+	return t.contract.MetricsPath
 }
 
+// ShutdownEndpoint returns the shutdown endpoint path
 func (t *TestcontainerAppContainer) ShutdownEndpoint() string {
-	if t.skeletonConfig != nil {
-		// Default shutdown endpoint for skeleton applications
-		return "/shutdown"
-	}
-	return "/shutdown"
+	return t.contract.ShutdownPath
 }
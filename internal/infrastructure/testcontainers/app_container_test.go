@@ -0,0 +1,284 @@
+package testcontainers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fintechain/skeleton-testkit/internal/domain/container"
+	"github.com/fintechain/skeleton-testkit/internal/infrastructure/docker"
+)
+
+// fakeDependency is a dependency reporting a fixed connection string
+type fakeDependency struct {
+	container.Container
+	connStr string
+}
+
+func (f *fakeDependency) ConnectionString() string {
+	return f.connStr
+}
+
+func newTestAppContainer(env map[string]string, skeletonConfig *container.SkeletonConfig) *TestcontainerAppContainer {
+	return NewTestcontainerAppContainer(&docker.ContainerConfig{
+		Name:        "app",
+		Image:       "skeleton-app:test",
+		Environment: env,
+	}, skeletonConfig)
+}
+
+func TestResolvedEnvironmentPrecedence(t *testing.T) {
+	t.Setenv("TESTKIT_INHERITED", "from-host")
+	t.Setenv("TESTKIT_EXPLICIT", "from-host")
+
+	skeletonConfig := &container.SkeletonConfig{
+		ServiceID: "orders",
+		Plugins: []container.SkeletonPluginConfig{
+			{Name: "cache", Config: map[string]interface{}{"ttl": "30s", "size": 128}},
+		},
+	}
+	app := newTestAppContainer(map[string]string{
+		"TESTKIT_EXPLICIT":      "explicit",
+		"APP_CACHE_CONFIG_TTL":  "explicit",
+		"DATABASE_URL":          "explicit",
+		"SKELETON_SERVICE_ID":   "explicit",
+		"TESTKIT_ONLY_EXPLICIT": "explicit",
+	}, skeletonConfig)
+	app.SetPluginEnvPrefix("app")
+	app.AddInheritEnv("TESTKIT_INHERITED", "TESTKIT_EXPLICIT", "TESTKIT_UNSET")
+	app.SetDependencyEnv("DATABASE_URL", &fakeDependency{connStr: "postgres://db"})
+	app.SetDependencyEnv("CACHE_URL", &fakeDependency{connStr: "redis://cache"})
+
+	env, err := app.ResolvedEnvironment()
+	require.NoError(t, err)
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"TESTKIT_EXPLICIT", "explicit"},
+		{"TESTKIT_ONLY_EXPLICIT", "explicit"},
+		{"TESTKIT_INHERITED", "from-host"},
+		{"APP_CACHE_CONFIG_TTL", "explicit"},
+		{"APP_CACHE_CONFIG_SIZE", "128"},
+		{"DATABASE_URL", "explicit"},
+		{"CACHE_URL", "redis://cache"},
+		// Skeleton fields are derived from the configuration
+		{"SKELETON_SERVICE_ID", "orders"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			require.Equal(t, tt.want, env[tt.key])
+		})
+	}
+
+	require.NotContains(t, env, "TESTKIT_UNSET")
+}
+
+func TestResolvedEnvironmentInjection(t *testing.T) {
+	skeletonConfig := &container.SkeletonConfig{
+		ServiceID: "orders",
+		Storage:   container.SkeletonStorageConfig{Type: "postgres", URL: "postgres://db"},
+	}
+
+	tests := []struct {
+		name       string
+		injection  container.SkeletonConfigInjection
+		wantJSON   bool
+		wantFields bool
+	}{
+		{"JSON and fields", container.InjectBoth, true, true},
+		{"JSON only", container.InjectJSONOnly, true, false},
+		{"fields only", container.InjectFieldsOnly, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestAppContainer(nil, skeletonConfig)
+			app.SetSkeletonConfigInjection(tt.injection)
+			app.SetSkeletonConfigEnvKey("ORDERS_CONFIG")
+			app.SetServiceIDEnvKey("ORDERS_SERVICE_ID")
+
+			env, err := app.ResolvedEnvironment()
+			require.NoError(t, err)
+
+			if tt.wantJSON {
+				require.JSONEq(t, `{"serviceId":"orders","plugins":null,"storage":{"type":"postgres","url":"postgres://db"}}`, env["ORDERS_CONFIG"])
+			} else {
+				require.NotContains(t, env, "ORDERS_CONFIG")
+			}
+
+			if tt.wantFields {
+				require.Equal(t, "orders", env["ORDERS_SERVICE_ID"])
+				require.Equal(t, "postgres", env["SKELETON_STORAGE_TYPE"])
+				require.Equal(t, "postgres://db", env["SKELETON_STORAGE_URL"])
+			} else {
+				require.NotContains(t, env, "ORDERS_SERVICE_ID")
+				require.NotContains(t, env, "SKELETON_STORAGE_TYPE")
+			}
+			require.NotContains(t, env, "SKELETON_CONFIG")
+			require.NotContains(t, env, "SKELETON_SERVICE_ID")
+		})
+	}
+}
+
+func TestResolvedEnvironmentUnknownPluginOverride(t *testing.T) {
+	app := newTestAppContainer(nil, &container.SkeletonConfig{ServiceID: "orders"})
+	app.AddPluginConfigOverride("missing", "ttl", "1m")
+
+	_, err := app.ResolvedEnvironment()
+
+	var errs container.ConfigErrors
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, container.ConfigErrors{
+		{Field: "plugins.missing.config.ttl", Reason: "overrides unknown plugin missing"},
+	}, errs)
+}
+
+func TestPluginConfigOverrideLeavesBaseConfig(t *testing.T) {
+	skeletonConfig := &container.SkeletonConfig{
+		ServiceID: "orders",
+		Plugins: []container.SkeletonPluginConfig{
+			{Name: "cache", Config: map[string]interface{}{"redis": map[string]interface{}{"db": 0, "host": "cache"}}},
+		},
+	}
+	app := newTestAppContainer(nil, skeletonConfig)
+	app.SetPluginEnvPrefix("app")
+	app.AddPluginConfigOverride("cache", "redis.db", 3)
+
+	env, err := app.ResolvedEnvironment()
+	require.NoError(t, err)
+
+	require.Equal(t, "3", env["APP_CACHE_CONFIG_REDIS_DB"])
+	require.Equal(t, "cache", env["APP_CACHE_CONFIG_REDIS_HOST"])
+	require.Equal(t, 0, skeletonConfig.Plugins[0].Config["redis"].(map[string]interface{})["db"])
+}
+
+func TestEnvName(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  string
+	}{
+		{"single part", []string{"app"}, "APP"},
+		{"joined parts", []string{"app", "cache", "CONFIG"}, "APP_CACHE_CONFIG"},
+		{"dashes", []string{"app", "rate-limiter"}, "APP_RATE_LIMITER"},
+		{"dots and spaces", []string{"app", "http.max conns"}, "APP_HTTP_MAX_CONNS"},
+		{"digits", []string{"v2", "s3"}, "V2_S3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, envName(tt.parts...))
+		})
+	}
+}
+
+func TestPluginEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins []container.SkeletonPluginConfig
+		want    map[string]string
+	}{
+		{
+			name: "string values",
+			plugins: []container.SkeletonPluginConfig{
+				{Name: "rate-limiter", Config: map[string]interface{}{"window": "1m"}},
+			},
+			want: map[string]string{"APP_RATE_LIMITER_CONFIG_WINDOW": "1m"},
+		},
+		{
+			name: "non-string values are JSON encoded",
+			plugins: []container.SkeletonPluginConfig{
+				{Name: "cache", Config: map[string]interface{}{
+					"size":    128,
+					"enabled": true,
+					"hosts":   []string{"a", "b"},
+				}},
+			},
+			want: map[string]string{
+				"APP_CACHE_CONFIG_SIZE":    "128",
+				"APP_CACHE_CONFIG_ENABLED": "true",
+				"APP_CACHE_CONFIG_HOSTS":   `["a","b"]`,
+			},
+		},
+		{
+			name: "nested objects",
+			plugins: []container.SkeletonPluginConfig{
+				{Name: "cache", Config: map[string]interface{}{
+					"redis": map[string]interface{}{
+						"pool": map[string]interface{}{"max-idle": 4},
+						"host": "cache",
+					},
+				}},
+			},
+			want: map[string]string{
+				"APP_CACHE_CONFIG_REDIS_POOL_MAX_IDLE": "4",
+				"APP_CACHE_CONFIG_REDIS_HOST":          "cache",
+			},
+		},
+		{
+			name: "multiple plugins",
+			plugins: []container.SkeletonPluginConfig{
+				{Name: "cache", Config: map[string]interface{}{"ttl": "30s"}},
+				{Name: "auth"},
+				{Name: "queue", Config: map[string]interface{}{"topic": "orders"}},
+			},
+			want: map[string]string{
+				"APP_CACHE_CONFIG_TTL":   "30s",
+				"APP_QUEUE_CONFIG_TOPIC": "orders",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, pluginEnv("app", tt.plugins))
+		})
+	}
+}
+
+func TestResolvedEnvironmentFakeClock(t *testing.T) {
+	app := newTestAppContainer(map[string]string{"FAKETIME_NO_CACHE": "0"}, nil)
+	app.Config().Platform = "linux/arm64"
+	app.Config().SetFakeClock(docker.FakeClock{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	env, err := app.ResolvedEnvironment()
+	require.NoError(t, err)
+
+	require.Equal(t, "/usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1", env["LD_PRELOAD"])
+	require.Equal(t, "0", env["FAKETIME_NO_CACHE"])
+	require.Contains(t, env, "FAKETIME_TIMESTAMP_FILE")
+}
+
+func TestDependencyReadyTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		dep  container.Container
+		want time.Duration
+	}{
+		{
+			name: "dependency timeout",
+			dep:  docker.NewDockerContainer(&docker.ContainerConfig{Name: "db", ReadyTimeout: 2 * time.Minute}),
+			want: 2 * time.Minute,
+		},
+		{
+			name: "dependency without timeout",
+			dep:  docker.NewDockerContainer(&docker.ContainerConfig{Name: "db"}),
+			want: docker.DefaultReadyTimeout(),
+		},
+		{
+			name: "dependency without readiness configuration",
+			dep:  &fakeDependency{connStr: "postgres://db"},
+			want: docker.DefaultReadyTimeout(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, dependencyReadyTimeout(tt.dep))
+		})
+	}
+}
@@ -3,6 +3,8 @@ package testcontainers
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/testcontainers/testcontainers-go"
@@ -18,6 +20,20 @@ type PostgresContainer struct {
 	database string
 	username string
 	password string
+	format   ConnectionStringFormat
+	snapshot bool
+}
+
+// snapshotPath is the in-container path of the dump taken by Snapshot
+const snapshotPath = "/tmp/skeleton-testkit-snapshot.dump"
+
+// ConnectionStringFormat formats a PostgreSQL connection string from its parts
+type ConnectionStringFormat func(host string, port int, database, username, password string) string
+
+// DefaultConnectionStringFormat formats a postgres:// URL connection string
+func DefaultConnectionStringFormat(host string, port int, database, username, password string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		username, password, host, port, database)
 }
 
 // PostgresConfig holds PostgreSQL container configuration
@@ -40,10 +56,14 @@ func NewPostgresContainer() *PostgresContainer {
 
 // NewPostgresContainerWithConfig creates a new PostgreSQL container with custom configuration
 func NewPostgresContainerWithConfig(config *PostgresConfig) *PostgresContainer {
+	// Suffix the name with the unique part of the ID so that several
+	// containers can run side by side without a Docker name collision
+	suffix := time.Now().UnixNano()
 	containerConfig := &docker.ContainerConfig{
-		ID:    fmt.Sprintf("postgres-%d", time.Now().UnixNano()),
-		Name:  "postgres-test",
+		ID:    fmt.Sprintf("postgres-%d", suffix),
+		Name:  fmt.Sprintf("postgres-test-%d", suffix),
 		Image: config.Image,
+		Kind:  "postgres",
 		Environment: map[string]string{
 			"POSTGRES_DB":       config.Database,
 			"POSTGRES_USER":     config.Username,
@@ -52,6 +72,7 @@ func NewPostgresContainerWithConfig(config *PostgresConfig) *PostgresContainer {
 		Ports: []container.PortMapping{
 			{Internal: 5432, External: 0}, // Random external port
 		},
+		InitScriptDir: "/docker-entrypoint-initdb.d",
 	}
 
 	return &PostgresContainer{
@@ -59,13 +80,17 @@ func NewPostgresContainerWithConfig(config *PostgresConfig) *PostgresContainer {
 		database:        config.Database,
 		username:        config.Username,
 		password:        config.Password,
+		format:          DefaultConnectionStringFormat,
 	}
 }
 
 // Start starts the PostgreSQL container
 func (p *PostgresContainer) Start(ctx context.Context) error {
-	if err := p.createContainer(ctx); err != nil {
-		return err
+	// Reuse the container if it was already created, e.g. when restarting
+	if p.Container() == nil {
+		if err := p.createContainer(ctx); err != nil {
+			return err
+		}
 	}
 	return p.DockerContainer.Start(ctx)
 }
@@ -74,23 +99,34 @@ func (p *PostgresContainer) Start(ctx context.Context) error {
 func (p *PostgresContainer) createContainer(ctx context.Context) error {
 	config := p.Config()
 
+	if err := config.ValidateVolumes(); err != nil {
+		return &container.ContainerError{
+			Operation: "create",
+			Container: p.ID(),
+			Message:   "invalid postgres container configuration",
+			Cause:     err,
+		}
+	}
+
 	req := testcontainers.ContainerRequest{
-		Image:        config.Image,
-		Name:         config.Name,
-		Env:          config.Environment,
-		ExposedPorts: []string{"5432/tcp"},
-		WaitingFor: wait.ForAll(
-			wait.ForListeningPort("5432/tcp"),
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(30*time.Second),
+		Image:         config.Image,
+		ImagePlatform: config.Platform,
+		Name:          config.Name,
+		Env:           config.Environment,
+		ExposedPorts:  []string{"5432/tcp"},
+		WaitingFor: config.WaitStrategy(
+			wait.ForListeningPort("5432/tcp").
+				WithStartupTimeout(config.ReadinessTimeout()),
+			p.readyLogStrategy(config.ReadinessTimeout()),
 		),
+		Networks:           config.Networks,
+		NetworkAliases:     config.NetworkAliases,
+		ConfigModifier:     config.ConfigModifier(),
+		HostConfigModifier: config.HostConfigModifier(),
+		Files:              config.InitScriptFiles(),
 	}
 
-	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          false,
-	})
+	c, err := docker.CreateContainer(ctx, req)
 	if err != nil {
 		return &container.ContainerError{
 			Operation: "create",
@@ -104,6 +140,17 @@ func (p *PostgresContainer) createContainer(ctx context.Context) error {
 	return nil
 }
 
+// readyLogStrategy waits for the configured ready log line, or for the stock
+// image's ready message. On the first start the message is logged a second
+// time once the init scripts have run and the server has restarted; on a
+// restart of the container it is logged once.
+func (p *PostgresContainer) readyLogStrategy(timeout time.Duration) wait.Strategy {
+	if log := p.Config().ReadyLog; log != "" {
+		return p.ForLogSinceStart(log, 1, 1, timeout)
+	}
+	return p.ForLogSinceStart("database system is ready to accept connections", 2, 1, timeout)
+}
+
 // ConnectionString returns the PostgreSQL connection string
 func (p *PostgresContainer) ConnectionString() string {
 	host := p.Host()
@@ -116,8 +163,191 @@ func (p *PostgresContainer) ConnectionString() string {
 		return ""
 	}
 
-	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
-		p.username, p.password, host, port, p.database)
+	return p.connectionStringFor(host, port)
+}
+
+// ConnectionParams holds the resolved parts of a PostgreSQL connection
+type ConnectionParams struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+}
+
+// ConnectionParams returns the resolved connection parts; Host and Port are
+// empty until the container is started
+func (p *PostgresContainer) ConnectionParams() ConnectionParams {
+	params := ConnectionParams{
+		Host:     p.Host(),
+		User:     p.username,
+		Password: p.password,
+		Database: p.database,
+	}
+	if port, err := p.Port(5432); err == nil {
+		params.Port = port
+	}
+	return params
+}
+
+// InternalConnectionString returns the PostgreSQL connection string for use by
+// containers on the same user-defined network, or an empty string if the
+// container has no network alias
+func (p *PostgresContainer) InternalConnectionString() string {
+	alias := p.NetworkAlias()
+	if alias == "" {
+		return ""
+	}
+	return p.connectionStringFor(alias, 5432)
+}
+
+// SetConnectionStringFormat sets the format used by ConnectionString and
+// InternalConnectionString; a nil format restores the default
+func (p *PostgresContainer) SetConnectionStringFormat(format ConnectionStringFormat) {
+	if format == nil {
+		format = DefaultConnectionStringFormat
+	}
+	p.format = format
+}
+
+// connectionStringFor formats the connection string for the given host and port
+func (p *PostgresContainer) connectionStringFor(host string, port int) string {
+	return p.format(host, port, p.database, p.username, p.password)
+}
+
+// Reset drops and recreates the public schema, returning the database to an
+// empty state
+func (p *PostgresContainer) Reset(ctx context.Context) error {
+	statements := fmt.Sprintf(
+		"DROP SCHEMA public CASCADE; CREATE SCHEMA public; GRANT ALL ON SCHEMA public TO %s; GRANT ALL ON SCHEMA public TO public;",
+		quoteIdentifier(p.username))
+
+	return p.runTool(ctx, "reset", "failed to reset public schema",
+		"psql", "-v", "ON_ERROR_STOP=1", "-U", p.username, "-d", p.database, "-c", statements)
+}
+
+// quoteIdentifier quotes a name for use as an SQL identifier, doubling any
+// embedded double quotes
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// Snapshot dumps the current database inside the container so that it can
+// later be restored by ResetToSnapshot
+func (p *PostgresContainer) Snapshot(ctx context.Context) error {
+	if err := p.runTool(ctx, "snapshot", "failed to dump database",
+		"pg_dump", "-U", p.username, "-d", p.database, "-Fc", "-f", snapshotPath); err != nil {
+		return err
+	}
+
+	p.snapshot = true
+	return nil
+}
+
+// ResetToSnapshot recreates the database and restores the dump taken by Snapshot.
+// Open connections to the database are terminated, which requires PostgreSQL 13 or later.
+func (p *PostgresContainer) ResetToSnapshot(ctx context.Context) error {
+	if !p.snapshot {
+		return &container.ContainerError{
+			Operation: "reset",
+			Container: p.ID(),
+			Message:   "no snapshot has been taken",
+		}
+	}
+
+	if err := p.runTool(ctx, "reset", "failed to drop database",
+		"psql", "-v", "ON_ERROR_STOP=1", "-U", p.username, "-d", "postgres",
+		"-c", fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", quoteIdentifier(p.database))); err != nil {
+		return err
+	}
+
+	if err := p.runTool(ctx, "reset", "failed to recreate database",
+		"psql", "-v", "ON_ERROR_STOP=1", "-U", p.username, "-d", "postgres",
+		"-c", fmt.Sprintf("CREATE DATABASE %s OWNER %s", quoteIdentifier(p.database), quoteIdentifier(p.username))); err != nil {
+		return err
+	}
+
+	return p.runTool(ctx, "reset", "failed to restore snapshot",
+		"pg_restore", "--exit-on-error", "--no-owner", "-U", p.username, "-d", p.database, snapshotPath)
+}
+
+// WaitForRows polls until the query returns the expected number of rows or the
+// timeout elapses
+func (p *PostgresContainer) WaitForRows(ctx context.Context, query string, expectedCount int, timeout time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	lastCount := -1
+	var lastErr error
+	for {
+		count, err := p.countRows(timeoutCtx, query)
+		if err == nil && count == expectedCount {
+			return nil
+		}
+		lastCount, lastErr = count, err
+
+		select {
+		case <-timeoutCtx.Done():
+			if lastErr != nil {
+				return &container.ContainerError{
+					Operation: "wait_for_rows",
+					Container: p.ID(),
+					Message:   fmt.Sprintf("timeout waiting for %d rows", expectedCount),
+					Cause:     lastErr,
+				}
+			}
+			return &container.ContainerError{
+				Operation: "wait_for_rows",
+				Container: p.ID(),
+				Message:   fmt.Sprintf("timeout waiting for %d rows, last query returned %d", expectedCount, lastCount),
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// countRows returns the number of rows returned by the query, using psql
+// inside the container
+func (p *PostgresContainer) countRows(ctx context.Context, query string) (int, error) {
+	countQuery := fmt.Sprintf("SELECT count(*) FROM (%s) AS rows", strings.TrimRight(strings.TrimSpace(query), ";"))
+
+	exitCode, output, err := p.ExecWithOutput(ctx, []string{
+		"psql", "-v", "ON_ERROR_STOP=1", "-U", p.username, "-d", p.database, "-t", "-A", "-c", countQuery,
+	})
+	if err != nil {
+		return -1, err
+	}
+	if exitCode != 0 {
+		return -1, fmt.Errorf("query failed (exit code %d): %s", exitCode, strings.TrimSpace(output))
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return -1, fmt.Errorf("unexpected query output %q", strings.TrimSpace(output))
+	}
+	return count, nil
+}
+
+// runTool runs a PostgreSQL client tool inside the container, failing on a
+// non-zero exit code
+func (p *PostgresContainer) runTool(ctx context.Context, operation, message string, cmd ...string) error {
+	exitCode, output, err := p.ExecWithOutput(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	if exitCode != 0 {
+		return &container.ContainerError{
+			Operation: operation,
+			Container: p.ID(),
+			Message:   fmt.Sprintf("%s (exit code %d): %s", message, exitCode, strings.TrimSpace(output)),
+		}
+	}
+
+	return nil
 }
 
 // Database returns the database name
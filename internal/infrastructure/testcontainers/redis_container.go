@@ -39,10 +39,14 @@ func NewRedisContainerWithConfig(config *RedisConfig) *RedisContainer {
 		env["REDIS_PASSWORD"] = config.Password
 	}
 
+	// Suffix the name with the unique part of the ID so that several
+	// containers can run side by side without a Docker name collision
+	suffix := time.Now().UnixNano()
 	containerConfig := &docker.ContainerConfig{
-		ID:          fmt.Sprintf("redis-%d", time.Now().UnixNano()),
-		Name:        "redis-test",
+		ID:          fmt.Sprintf("redis-%d", suffix),
+		Name:        fmt.Sprintf("redis-test-%d", suffix),
 		Image:       config.Image,
+		Kind:        "redis",
 		Environment: env,
 		Ports: []container.PortMapping{
 			{Internal: 6379, External: 0}, // Random external port
@@ -57,38 +61,65 @@ func NewRedisContainerWithConfig(config *RedisConfig) *RedisContainer {
 
 // Start starts the Redis container
 func (r *RedisContainer) Start(ctx context.Context) error {
-	if err := r.createContainer(ctx); err != nil {
-		return err
+	// Reuse the container if it was already created, e.g. when restarting
+	if r.Container() == nil {
+		if err := r.createContainer(ctx); err != nil {
+			return err
+		}
 	}
 	return r.DockerContainer.Start(ctx)
 }
 
+// Command returns the command the Redis server runs with
+func (r *RedisContainer) Command() []string {
+	cmd := []string{"redis-server"}
+	if r.password != "" {
+		cmd = append(cmd, "--requirepass", r.password)
+	}
+	return cmd
+}
+
+// readyLog returns the log line the default readiness strategy waits for
+func (r *RedisContainer) readyLog() string {
+	if log := r.Config().ReadyLog; log != "" {
+		return log
+	}
+	return "Ready to accept connections"
+}
+
 // createContainer creates the underlying testcontainer
 func (r *RedisContainer) createContainer(ctx context.Context) error {
 	config := r.Config()
 
-	cmd := []string{"redis-server"}
-	if r.password != "" {
-		cmd = append(cmd, "--requirepass", r.password)
+	if err := config.ValidateVolumes(); err != nil {
+		return &container.ContainerError{
+			Operation: "create",
+			Container: r.ID(),
+			Message:   "invalid redis container configuration",
+			Cause:     err,
+		}
 	}
 
 	req := testcontainers.ContainerRequest{
-		Image:        config.Image,
-		Name:         config.Name,
-		Env:          config.Environment,
-		ExposedPorts: []string{"6379/tcp"},
-		Cmd:          cmd,
-		WaitingFor: wait.ForAll(
-			wait.ForListeningPort("6379/tcp"),
-			wait.ForLog("Ready to accept connections").
-				WithStartupTimeout(30*time.Second),
+		Image:         config.Image,
+		ImagePlatform: config.Platform,
+		Name:          config.Name,
+		Env:           config.Environment,
+		ExposedPorts:  []string{"6379/tcp"},
+		Cmd:           r.Command(),
+		WaitingFor: config.WaitStrategy(
+			wait.ForListeningPort("6379/tcp").
+				WithStartupTimeout(config.ReadinessTimeout()),
+			r.ForLogSinceStart(r.readyLog(), 1, 1, config.ReadinessTimeout()),
 		),
+		Networks:           config.Networks,
+		NetworkAliases:     config.NetworkAliases,
+		ConfigModifier:     config.ConfigModifier(),
+		HostConfigModifier: config.HostConfigModifier(),
+		Files:              config.InitScriptFiles(),
 	}
 
-	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
-		ContainerRequest: req,
-		Started:          false,
-	})
+	c, err := docker.CreateContainer(ctx, req)
 	if err != nil {
 		return &container.ContainerError{
 			Operation: "create",
@@ -114,6 +145,22 @@ func (r *RedisContainer) ConnectionString() string {
 		return ""
 	}
 
+	return r.connectionStringFor(host, port)
+}
+
+// InternalConnectionString returns the Redis connection string for use by
+// containers on the same user-defined network, or an empty string if the
+// container has no network alias
+func (r *RedisContainer) InternalConnectionString() string {
+	alias := r.NetworkAlias()
+	if alias == "" {
+		return ""
+	}
+	return r.connectionStringFor(alias, 6379)
+}
+
+// connectionStringFor formats the connection string for the given host and port
+func (r *RedisContainer) connectionStringFor(host string, port int) string {
 	if r.password != "" {
 		return fmt.Sprintf("redis://:%s@%s:%d", r.password, host, port)
 	}
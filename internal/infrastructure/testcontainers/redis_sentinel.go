@@ -0,0 +1,379 @@
+package testcontainers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/fintechain/skeleton-testkit/internal/domain/container"
+	"github.com/fintechain/skeleton-testkit/internal/infrastructure/docker"
+)
+
+const (
+	redisPort    = 6379
+	sentinelPort = 26379
+
+	// redisPrimaryAlias is the network alias of the primary node
+	redisPrimaryAlias = "redis-primary"
+)
+
+// RedisSentinelConfig holds Redis Sentinel topology configuration
+type RedisSentinelConfig struct {
+	Image      string
+	MasterName string
+	Replicas   int
+	Sentinels  int
+	// Quorum is the number of sentinels that must agree the primary is down;
+	// zero uses a majority of the sentinels
+	Quorum int
+	// DownAfter is how long the primary must be unreachable before it is
+	// considered down
+	DownAfter time.Duration
+}
+
+// redisNode is a single Redis server or sentinel of a sentinel topology
+type redisNode struct {
+	*docker.DockerContainer
+	alias string
+	port  int
+	cmd   []string
+}
+
+// newRedisNode creates a node of the topology reachable under alias on network
+func newRedisNode(image, network, alias string, port int, cmd []string) *redisNode {
+	kind := "redis"
+	if port == sentinelPort {
+		kind = "redis-sentinel"
+	}
+
+	suffix := time.Now().UnixNano()
+	config := &docker.ContainerConfig{
+		ID:    fmt.Sprintf("%s-%d", alias, suffix),
+		Name:  fmt.Sprintf("%s-test-%d", alias, suffix),
+		Image: image,
+		Kind:  kind,
+		Ports: []container.PortMapping{
+			{Internal: port, External: 0}, // Random external port
+		},
+	}
+
+	node := &redisNode{
+		DockerContainer: docker.NewDockerContainer(config),
+		alias:           alias,
+		port:            port,
+		cmd:             cmd,
+	}
+	node.JoinNetwork(network, alias)
+	return node
+}
+
+// Start creates the node's container if needed and starts it
+func (n *redisNode) Start(ctx context.Context) error {
+	if n.Container() == nil {
+		if err := n.createContainer(ctx); err != nil {
+			return err
+		}
+	}
+	return n.DockerContainer.Start(ctx)
+}
+
+// createContainer creates the underlying testcontainer
+func (n *redisNode) createContainer(ctx context.Context) error {
+	config := n.Config()
+	port := fmt.Sprintf("%d/tcp", n.port)
+
+	req := testcontainers.ContainerRequest{
+		Image:         config.Image,
+		ImagePlatform: config.Platform,
+		Name:          config.Name,
+		Env:           config.Environment,
+		ExposedPorts:  []string{port},
+		Cmd:           n.cmd,
+		WaitingFor: config.WaitStrategy(
+			wait.ForListeningPort(nat.Port(port)).
+				WithStartupTimeout(config.ReadinessTimeout()),
+		),
+		Networks:           config.Networks,
+		NetworkAliases:     config.NetworkAliases,
+		ConfigModifier:     config.ConfigModifier(),
+		HostConfigModifier: config.HostConfigModifier(),
+	}
+
+	c, err := docker.CreateContainer(ctx, req)
+	if err != nil {
+		return &container.ContainerError{
+			Operation: "create",
+			Container: n.ID(),
+			Message:   fmt.Sprintf("failed to create %s container", n.alias),
+			Cause:     err,
+		}
+	}
+
+	n.SetContainer(c)
+	return nil
+}
+
+// endpoint returns the host address of the node
+func (n *redisNode) endpoint() string {
+	host := n.Host()
+	if host == "" {
+		return ""
+	}
+
+	port, err := n.Port(n.port)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
+// RedisSentinelCluster runs a Redis primary with replicas monitored by
+// sentinels, all on a dedicated network. Nodes announce their network aliases,
+// so the addresses sentinels report are only reachable from containers on
+// the cluster network.
+type RedisSentinelCluster struct {
+	config      RedisSentinelConfig
+	networkName string
+	network     testcontainers.Network
+	primary     *redisNode
+	replicas    []*redisNode
+	sentinels   []*redisNode
+}
+
+// NewRedisSentinelCluster creates a new Redis Sentinel topology
+func NewRedisSentinelCluster(config RedisSentinelConfig) *RedisSentinelCluster {
+	if config.Image == "" {
+		config.Image = "redis:7"
+	}
+	if config.MasterName == "" {
+		config.MasterName = "mymaster"
+	}
+	if config.Sentinels <= 0 {
+		config.Sentinels = 3
+	}
+	if config.Quorum <= 0 {
+		config.Quorum = config.Sentinels/2 + 1
+	}
+	if config.DownAfter <= 0 {
+		config.DownAfter = 5 * time.Second
+	}
+
+	networkName := fmt.Sprintf("skeleton-testkit-sentinel-%d", time.Now().UnixNano())
+
+	cluster := &RedisSentinelCluster{
+		config:      config,
+		networkName: networkName,
+		primary: newRedisNode(config.Image, networkName, redisPrimaryAlias, redisPort, []string{
+			"redis-server", "--replica-announce-ip", redisPrimaryAlias,
+		}),
+	}
+
+	for i := 1; i <= config.Replicas; i++ {
+		alias := fmt.Sprintf("redis-replica-%d", i)
+		cluster.replicas = append(cluster.replicas, newRedisNode(config.Image, networkName, alias, redisPort, []string{
+			"redis-server",
+			"--replicaof", redisPrimaryAlias, strconv.Itoa(redisPort),
+			"--replica-announce-ip", alias,
+		}))
+	}
+
+	for i := 1; i <= config.Sentinels; i++ {
+		alias := fmt.Sprintf("redis-sentinel-%d", i)
+		cluster.sentinels = append(cluster.sentinels, newRedisNode(config.Image, networkName, alias, sentinelPort,
+			sentinelCommand(config, alias)))
+	}
+
+	return cluster
+}
+
+// sentinelCommand returns the command writing the sentinel configuration and
+// running the sentinel; the configuration must be a writable file, since
+// sentinels rewrite it as the topology changes
+func sentinelCommand(config RedisSentinelConfig, alias string) []string {
+	lines := []string{
+		fmt.Sprintf("port %d", sentinelPort),
+		"sentinel resolve-hostnames yes",
+		"sentinel announce-hostnames yes",
+		fmt.Sprintf("sentinel announce-ip %s", alias),
+		fmt.Sprintf("sentinel monitor %s %s %d %d", config.MasterName, redisPrimaryAlias, redisPort, config.Quorum),
+		fmt.Sprintf("sentinel down-after-milliseconds %s %d", config.MasterName, config.DownAfter.Milliseconds()),
+		fmt.Sprintf("sentinel failover-timeout %s %d", config.MasterName, (2 * config.DownAfter).Milliseconds()),
+	}
+
+	script := fmt.Sprintf("printf '%%s\\n' '%s' > /tmp/sentinel.conf && exec redis-sentinel /tmp/sentinel.conf",
+		strings.Join(lines, "' '"))
+	return []string{"sh", "-c", script}
+}
+
+// MasterName returns the name the sentinels monitor the primary under
+func (c *RedisSentinelCluster) MasterName() string {
+	return c.config.MasterName
+}
+
+// NetworkName returns the name of the cluster network
+func (c *RedisSentinelCluster) NetworkName() string {
+	return c.networkName
+}
+
+// SentinelEndpoints returns the host addresses (host:port) of the sentinels
+func (c *RedisSentinelCluster) SentinelEndpoints() []string {
+	endpoints := make([]string, 0, len(c.sentinels))
+	for _, sentinel := range c.sentinels {
+		if endpoint := sentinel.endpoint(); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// InternalSentinelEndpoints returns the in-network addresses (alias:port) of
+// the sentinels
+func (c *RedisSentinelCluster) InternalSentinelEndpoints() []string {
+	endpoints := make([]string, len(c.sentinels))
+	for i, sentinel := range c.sentinels {
+		endpoints[i] = fmt.Sprintf("%s:%d", sentinel.alias, sentinelPort)
+	}
+	return endpoints
+}
+
+// PrimaryEndpoint returns the host address of the initial primary
+func (c *RedisSentinelCluster) PrimaryEndpoint() string {
+	return c.primary.endpoint()
+}
+
+// nodes returns every node of the topology in start order
+func (c *RedisSentinelCluster) nodes() []*redisNode {
+	nodes := []*redisNode{c.primary}
+	nodes = append(nodes, c.replicas...)
+	return append(nodes, c.sentinels...)
+}
+
+// Start creates the cluster network, starts the primary, replicas and
+// sentinels in that order, and waits until every sentinel sees the primary
+// with all replicas and the other sentinels
+func (c *RedisSentinelCluster) Start(ctx context.Context) error {
+	if c.network == nil {
+		network, err := docker.CreateNetwork(ctx, c.networkName)
+		if err != nil {
+			return err
+		}
+		c.network = network
+	}
+
+	for _, node := range c.nodes() {
+		if err := node.Start(ctx); err != nil {
+			return err
+		}
+	}
+
+	return c.WaitForReady(ctx, c.primary.Config().ReadinessTimeout())
+}
+
+// WaitForReady waits until every sentinel reports the primary as healthy with
+// all replicas and the other sentinels discovered
+func (c *RedisSentinelCluster) WaitForReady(ctx context.Context, timeout time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for _, sentinel := range c.sentinels {
+		for {
+			lastErr := c.checkSentinel(timeoutCtx, sentinel)
+			if lastErr == nil {
+				break
+			}
+
+			select {
+			case <-timeoutCtx.Done():
+				return &container.ContainerError{
+					Operation: "wait_for_ready",
+					Container: sentinel.ID(),
+					Message:   "timeout waiting for sentinel topology to be healthy",
+					Cause:     lastErr,
+				}
+			case <-ticker.C:
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSentinel checks once whether a sentinel sees the complete topology
+func (c *RedisSentinelCluster) checkSentinel(ctx context.Context, sentinel *redisNode) error {
+	exitCode, output, err := sentinel.ExecWithOutput(ctx, []string{
+		"redis-cli", "-p", strconv.Itoa(sentinelPort), "sentinel", "master", c.config.MasterName,
+	})
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("sentinel query exited with code %d: %s", exitCode, strings.TrimSpace(output))
+	}
+
+	fields := parseRedisFields(output)
+	if flags := fields["flags"]; flags != "master" {
+		return fmt.Errorf("sentinel reports primary flags %q", flags)
+	}
+	if replicas := fields["num-slaves"]; replicas != strconv.Itoa(len(c.replicas)) {
+		return fmt.Errorf("sentinel sees %s of %d replicas", replicas, len(c.replicas))
+	}
+	if others := fields["num-other-sentinels"]; others != strconv.Itoa(len(c.sentinels)-1) {
+		return fmt.Errorf("sentinel sees %s of %d other sentinels", others, len(c.sentinels)-1)
+	}
+
+	return nil
+}
+
+// parseRedisFields parses the alternating field and value lines redis-cli
+// prints for a flat reply
+func parseRedisFields(output string) map[string]string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	fields := make(map[string]string, len(lines)/2)
+	for i := 0; i+1 < len(lines); i += 2 {
+		fields[strings.TrimSpace(lines[i])] = strings.TrimSpace(lines[i+1])
+	}
+	return fields
+}
+
+// Terminate removes every node, in reverse start order, and the cluster
+// network, continuing past failures
+func (c *RedisSentinelCluster) Terminate(ctx context.Context) error {
+	errs := container.NewMultiError()
+
+	nodes := c.nodes()
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if err := nodes[i].Terminate(ctx); err != nil {
+			errs.Add(&container.ContainerError{
+				Operation: "terminate",
+				Container: nodes[i].ID(),
+				Message:   fmt.Sprintf("failed to terminate %s", nodes[i].alias),
+				Cause:     err,
+			})
+		}
+	}
+
+	if c.network != nil {
+		cleanupCtx, cancel := docker.CleanupContext(ctx)
+		defer cancel()
+		if err := c.network.Remove(cleanupCtx); err != nil {
+			errs.Add(&container.ContainerError{
+				Operation: "remove_network",
+				Container: c.networkName,
+				Message:   "failed to remove sentinel cluster network",
+				Cause:     err,
+			})
+		}
+		c.network = nil
+	}
+
+	return errs.ErrorOrNil()
+}
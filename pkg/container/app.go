@@ -5,12 +5,77 @@ package container
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	dockercontainer "github.com/docker/docker/api/types/container"
+	tc "github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
 
 	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
 	"github.com/fintechain/skeleton-testkit/internal/infrastructure/docker"
 	"github.com/fintechain/skeleton-testkit/internal/infrastructure/testcontainers"
 )
 
+// LogConsumer receives a single container log line along with the stream
+// ("STDOUT" or "STDERR") it was written to.
+type LogConsumer = docker.LogConsumer
+
+// DeviceRequest describes a request for host devices, such as GPUs, to be
+// made available to a container.
+type DeviceRequest = docker.DeviceRequest
+
+// Ulimit describes a resource limit applied to the processes of a container.
+type Ulimit = docker.Ulimit
+
+// ErrArchMismatch is matched, using errors.Is, by Start and WaitForReady
+// errors of containers whose image was built for another CPU architecture than
+// the host's, e.g. an amd64-only image on Apple Silicon without emulation.
+var ErrArchMismatch = domaincontainer.ErrArchMismatch
+
+// StopRecord records the stop of a single dependency container: its name,
+// when the stop returned and the error it returned, if any.
+type StopRecord = domaincontainer.StopRecord
+
+// StartupMetrics records how long each phase of an application start took:
+// starting the dependencies, creating the container (including pulling or
+// building its image) and starting it until ready.
+type StartupMetrics = domaincontainer.StartupMetrics
+
+// ConfigError describes a single invalid configuration field: its path, such
+// as "plugins[1].name", and why its value is invalid.
+type ConfigError = domaincontainer.ConfigError
+
+// ConfigErrors lists every invalid field found by a configuration validation.
+// Retrieve it from a returned error with errors.As to inspect the fields.
+type ConfigErrors = domaincontainer.ConfigErrors
+
+// SkeletonConfigInjection selects how the skeleton configuration is passed to
+// the application through its environment.
+type SkeletonConfigInjection = domaincontainer.SkeletonConfigInjection
+
+const (
+	// InjectBoth passes the SKELETON_CONFIG JSON and the individual SKELETON_* fields (default)
+	InjectBoth = domaincontainer.InjectBoth
+	// InjectJSONOnly passes only the SKELETON_CONFIG JSON
+	InjectJSONOnly = domaincontainer.InjectJSONOnly
+	// InjectFieldsOnly passes only the individual SKELETON_* fields
+	InjectFieldsOnly = domaincontainer.InjectFieldsOnly
+)
+
+// SkeletonService describes the identity of a skeleton service along with the
+// endpoint paths it exposes. Empty paths keep their current values.
+type SkeletonService struct {
+	ID           string
+	HealthPath   string
+	MetricsPath  string
+	ShutdownPath string
+}
+
 // AppContainer represents a containerized skeleton-based application for testing.
 // It provides a fluent API for configuring the application container with
 // dependencies, environment variables, and skeleton-specific settings.
@@ -50,20 +115,36 @@ func NewAppContainer(impl *testcontainers.TestcontainerAppContainer) *AppContain
 //	    },
 //	})
 func (a *AppContainer) WithSkeletonConfig(config *domaincontainer.SkeletonConfig) *AppContainer {
-	// Create new container with skeleton config
-	containerConfig := a.impl.Config()
-	newImpl := testcontainers.NewTestcontainerAppContainer(containerConfig, config)
-
-	// Copy dependencies
-	for _, dep := range a.impl.Dependencies() {
-		newImpl.AddDependency(dep)
-	}
+	a.impl.SetSkeletonConfig(config)
+	return a
+}
 
-	return &AppContainer{impl: newImpl}
+// WithSkeletonContract overrides the HTTP port and endpoint paths the application
+// exposes, for images that don't follow the default skeleton contract. The contract
+// is used for readiness validation, connection strings, and by the verifiers.
+// Zero-valued fields of the contract keep their defaults.
+//
+// Parameters:
+//   - contract: The ports and endpoint paths exposed by the application
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonContract(fixtures.SkeletonContract{
+//	    HTTPPort:   9090,
+//	    HealthPath: "/healthz",
+//	})
+func (a *AppContainer) WithSkeletonContract(contract domaincontainer.SkeletonContract) *AppContainer {
+	a.impl.SetSkeletonContract(contract)
+	return a
 }
 
 // WithSkeletonPlugins configures skeleton plugins for the application.
-// This is a convenience method for setting up multiple plugins.
+// This is a convenience method for setting up multiple plugins. The plugins
+// replace any previously configured plugins, while the other skeleton
+// settings, such as the service ID and storage, are kept.
 //
 // Parameters:
 //   - plugins: List of skeleton plugin configurations
@@ -78,12 +159,278 @@ func (a *AppContainer) WithSkeletonConfig(config *domaincontainer.SkeletonConfig
 //	    {Name: "api-plugin", Version: "2.0.0"},
 //	})
 func (a *AppContainer) WithSkeletonPlugins(plugins []domaincontainer.SkeletonPluginConfig) *AppContainer {
-	config := &domaincontainer.SkeletonConfig{
-		Plugins: plugins,
-	}
+	config := a.copySkeletonConfig()
+	config.Plugins = plugins
 	return a.WithSkeletonConfig(config)
 }
 
+// WithSkeletonService defines the service contract in one call: it sets the
+// service ID of the skeleton configuration, keeping its other settings, and
+// the health, metrics and shutdown endpoint paths used for readiness and by
+// the verifiers.
+//
+// Parameters:
+//   - service: The service ID and endpoint paths
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonService(container.SkeletonService{
+//	    ID:          "payments",
+//	    HealthPath:  "/healthz",
+//	    MetricsPath: "/internal/metrics",
+//	})
+func (a *AppContainer) WithSkeletonService(service SkeletonService) *AppContainer {
+	config := a.copySkeletonConfig()
+	config.ServiceID = service.ID
+	a.WithSkeletonConfig(config)
+
+	return a.WithSkeletonContract(domaincontainer.SkeletonContract{
+		HealthPath:   service.HealthPath,
+		MetricsPath:  service.MetricsPath,
+		ShutdownPath: service.ShutdownPath,
+	})
+}
+
+// WithSkeletonPlugin adds a single skeleton plugin with its configuration to
+// the application, keeping the plugins and the other skeleton settings, such as
+// the service ID and storage, that are already configured.
+//
+// Parameters:
+//   - name: Plugin name
+//   - version: Plugin version
+//   - config: Plugin configuration, may be nil
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonPlugin("auth-plugin", "1.0.0", map[string]interface{}{"timeout": 30}).
+//	    WithSkeletonPlugin("api-plugin", "2.0.0", nil)
+func (a *AppContainer) WithSkeletonPlugin(name, version string, config map[string]interface{}) *AppContainer {
+	skeletonConfig := a.copySkeletonConfig()
+	skeletonConfig.Plugins = append(skeletonConfig.Plugins, domaincontainer.SkeletonPluginConfig{
+		Name:    name,
+		Version: version,
+		Config:  config,
+	})
+	return a.WithSkeletonConfig(skeletonConfig)
+}
+
+// copySkeletonConfig returns a copy of the current skeleton configuration, or
+// an empty one, that can be modified without affecting the caller's value
+func (a *AppContainer) copySkeletonConfig() *domaincontainer.SkeletonConfig {
+	config := &domaincontainer.SkeletonConfig{}
+	if current := a.impl.SkeletonConfig(); current != nil {
+		*config = *current
+		config.Plugins = append([]domaincontainer.SkeletonPluginConfig(nil), current.Plugins...)
+	}
+	return config
+}
+
+// WithComponentConfigOverride overrides a single value of a plugin's
+// configuration, so that a base SkeletonConfig can be shared across tests with
+// per-test tweaks. The override is deep-merged into a copy of the plugin's
+// Config when the container is created at Start; the base configuration is not
+// modified. Dots in key address nested objects. Start fails if no plugin of
+// the given name is configured.
+//
+// Parameters:
+//   - pluginName: Name of the configured plugin
+//   - key: Configuration key, with dots addressing nested objects (e.g. "pool.size")
+//   - value: The value to set; object values are merged into existing objects
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonConfig(fixtures.BaseSkeletonConfig).
+//	    WithComponentConfigOverride("storage-plugin", "pool.size", 1)
+func (a *AppContainer) WithComponentConfigOverride(pluginName, key string, value interface{}) *AppContainer {
+	a.impl.AddPluginConfigOverride(pluginName, key, value)
+	return a
+}
+
+// WithSkeletonConfigInjection sets how the skeleton configuration reaches the
+// application: as the SKELETON_CONFIG JSON, as the individual SKELETON_SERVICE_ID
+// and SKELETON_STORAGE_* fields, or both (the default). Use InjectFieldsOnly
+// for applications that validate their environment strictly and reject the
+// JSON variable.
+//
+// Parameters:
+//   - mode: InjectBoth, InjectJSONOnly or InjectFieldsOnly
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithSkeletonConfigInjection(mode SkeletonConfigInjection) *AppContainer {
+	a.impl.SetSkeletonConfigInjection(mode)
+	return a
+}
+
+// WithSkeletonConfigEnvKey sets the environment variable the skeleton
+// configuration JSON is injected as, for applications that do not follow the
+// skeleton framework's SKELETON_CONFIG convention.
+//
+// Parameters:
+//   - key: Environment variable name (e.g. "APP_CONFIG_JSON")
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithSkeletonConfigEnvKey(key string) *AppContainer {
+	a.impl.SetSkeletonConfigEnvKey(key)
+	return a
+}
+
+// WithServiceIDEnvKey sets the environment variable the service ID of the
+// skeleton configuration is injected as, instead of SKELETON_SERVICE_ID.
+//
+// Parameters:
+//   - key: Environment variable name (e.g. "SERVICE_ID")
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonConfigEnvKey("APP_CONFIG_JSON").WithServiceIDEnvKey("SERVICE_ID")
+func (a *AppContainer) WithServiceIDEnvKey(key string) *AppContainer {
+	a.impl.SetServiceIDEnvKey(key)
+	return a
+}
+
+// WithPluginEnvPrefix flattens each skeleton plugin's configuration into
+// individual environment variables, in addition to the SKELETON_CONFIG JSON,
+// for plugins that read their settings from the environment. Variables are
+// named <PREFIX>_<PLUGIN>_CONFIG_<KEY>; nested objects extend the key.
+//
+// Parameters:
+//   - prefix: Prefix of the generated variable names (e.g. "PLUGIN")
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithSkeletonPlugins([]container.SkeletonPluginConfig{
+//	    {Name: "auth", Config: map[string]interface{}{"timeout": 30}},
+//	}).WithPluginEnvPrefix("PLUGIN")
+//	// the container receives PLUGIN_AUTH_CONFIG_TIMEOUT=30
+func (a *AppContainer) WithPluginEnvPrefix(prefix string) *AppContainer {
+	a.impl.SetPluginEnvPrefix(prefix)
+	return a
+}
+
+// WithDependencyURL injects the connection string of a dependency into an
+// environment variable of the application. The connection string is resolved
+// when the application container is created, after the dependency has started,
+// become ready and passed its HealthCheck, so the application never boots
+// pointing at a dependency that is not yet healthy. The in-network connection
+// string is used when the dependency shares a network with the application.
+//
+// Parameters:
+//   - name: Environment variable name (e.g. "DATABASE_URL")
+//   - dep: Dependency container, which is added as a dependency if needed
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	postgres := testkit.NewPostgresContainer()
+//	app.WithDependencyURL("DATABASE_URL", postgres)
+func (a *AppContainer) WithDependencyURL(name string, dep domaincontainer.Container) *AppContainer {
+	a.ensureDependency(dep)
+
+	a.impl.SetDependencyEnv(name, dep)
+	return a
+}
+
+// WithDependencyHealthGate gates the application container's readiness on its
+// own health endpoint reporting the dependency as connected, so that Start
+// returns only once the application has actually reached the dependency
+// rather than once both containers are up. The dependency is added if it is
+// not already registered.
+//
+// The dependency is looked up in the health response under its type name
+// ("postgres", "redis", or the container name for other dependencies), at the
+// top level or under "checks", "dependencies", "components" or "details".
+// A boolean, a status such as "up" or "connected", or an object with a
+// "status", "healthy" or "connected" field is accepted.
+//
+// Parameters:
+//   - dep: Dependency the application must report as connected
+//   - healthPath: Application health endpoint path reporting the dependency
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	postgres := testkit.NewPostgresContainer()
+//	app.WithDependencyURL("DATABASE_URL", postgres).
+//	    WithDependencyHealthGate(postgres, "/health")
+func (a *AppContainer) WithDependencyHealthGate(dep domaincontainer.Container, healthPath string) *AppContainer {
+	a.ensureDependency(dep)
+
+	name := DependencyType(dep)
+	if name == "container" {
+		name = dep.Name()
+	}
+
+	a.impl.AddDependencyHealthGate(name, healthPath)
+	return a
+}
+
+// WithNamedDependency adds a dependency under a stable alias, so that tests
+// can later fetch it from the application with Dependency, e.g. to restart it,
+// without keeping the Go variable around. The dependency is added if it is not
+// already registered; an alias that is reused names the latest dependency.
+//
+// Parameters:
+//   - alias: Name to look the dependency up by (e.g. "primary-db")
+//   - dep: Dependency container
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithNamedDependency("primary-db", testkit.NewPostgresContainer())
+//	// ...
+//	db, err := app.Dependency("primary-db")
+func (a *AppContainer) WithNamedDependency(alias string, dep domaincontainer.Container) *AppContainer {
+	a.ensureDependency(dep)
+
+	a.impl.SetDependencyAlias(alias, dep)
+	return a
+}
+
+// Dependency returns the dependency added under alias with WithNamedDependency.
+//
+// Parameters:
+//   - alias: Name the dependency was added under
+//
+// Returns:
+//   - domaincontainer.Container: The dependency container
+//   - error: An error if no dependency is named alias
+func (a *AppContainer) Dependency(alias string) (domaincontainer.Container, error) {
+	return a.impl.Dependency(alias)
+}
+
+// ensureDependency adds dep as a dependency unless it is already registered
+func (a *AppContainer) ensureDependency(dep domaincontainer.Container) {
+	for _, existing := range a.impl.Dependencies() {
+		if existing == dep {
+			return
+		}
+	}
+	a.impl.AddDependency(dep)
+}
+
 // WithDatabase adds a database dependency to the application container.
 // The database will be started before the application container.
 //
@@ -154,7 +501,6 @@ func (a *AppContainer) WithMessageQueue(mq domaincontainer.Container) *AppContai
 //	    "DB_URL": postgres.ConnectionString(),
 //	})
 func (a *AppContainer) WithEnvironment(env map[string]string) *AppContainer {
-	// Create new config with updated environment
 	containerConfig := a.impl.Config()
 	newEnv := make(map[string]string)
 
@@ -168,158 +514,1083 @@ func (a *AppContainer) WithEnvironment(env map[string]string) *AppContainer {
 		newEnv[k] = v
 	}
 
-	// Create new container config
-	newContainerConfig := &docker.ContainerConfig{
-		ID:          containerConfig.ID,
-		Name:        containerConfig.Name,
-		Image:       containerConfig.Image,
-		Environment: newEnv,
-		Ports:       containerConfig.Ports,
-	}
-
-	// Create new implementation with updated config
-	newImpl := testcontainers.NewTestcontainerAppContainer(newContainerConfig, a.impl.SkeletonConfig())
-
-	// Copy dependencies
-	for _, dep := range a.impl.Dependencies() {
-		newImpl.AddDependency(dep)
-	}
-
-	return &AppContainer{impl: newImpl}
+	containerConfig.Environment = newEnv
+	return a
 }
 
-// WithHealthEndpoint sets the health check endpoint for the application.
-// This endpoint will be used for health monitoring and readiness checks.
+// WithInheritEnv passes variables of the test process environment through to
+// the application container without repeating their values, e.g. proxy
+// settings or CI-provided feature flags. Each variable is read when the
+// container is created at Start; unset variables are skipped and variables
+// set with WithEnvironment take precedence.
 //
 // Parameters:
-//   - endpoint: The health check endpoint path
+//   - keys: Names of the host environment variables to pass through
 //
 // Returns:
 //   - *AppContainer: The same container for method chaining
 //
 // Example:
 //
-//	app.WithHealthEndpoint("/health")
-func (a *AppContainer) WithHealthEndpoint(endpoint string) *AppContainer {
-	// For now, store the endpoint in the skeleton config or container metadata
-	// This would be used during health checks
-	// Since the current architecture doesn't have a direct way to store this,
-	// we'll return the same container (this is acceptable for Phase 1)
+//	app.WithInheritEnv("HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY")
+func (a *AppContainer) WithInheritEnv(keys ...string) *AppContainer {
+	a.impl.AddInheritEnv(keys...)
 	return a
 }
 
-// WithShutdownEndpoint sets the graceful shutdown endpoint for the application.
-// This endpoint can be used to trigger graceful shutdown of the application.
+// WithImageDigest pins the application image to a digest, guarding against a
+// moving tag such as :latest silently changing the application under test.
+// The image reference is rewritten to <repository>@<digest>; use
+// VerifyImageDigest after starting to confirm the running image.
 //
 // Parameters:
-//   - endpoint: The shutdown endpoint path
+//   - digest: The image digest (e.g. "sha256:4f...")
 //
 // Returns:
 //   - *AppContainer: The same container for method chaining
 //
 // Example:
 //
-//	app.WithShutdownEndpoint("/shutdown")
-func (a *AppContainer) WithShutdownEndpoint(endpoint string) *AppContainer {
-	// For now, store the endpoint in the skeleton config or container metadata
-	// This would be used during shutdown operations
-	// Since the current architecture doesn't have a direct way to store this,
-	// we'll return the same container (this is acceptable for Phase 1)
+//	app.WithImageDigest("sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945")
+func (a *AppContainer) WithImageDigest(digest string) *AppContainer {
+	config := a.impl.Config()
+	config.ImageDigest = digest
+	config.Image = docker.PinImageDigest(config.Image, digest)
 	return a
 }
 
-// Start starts the application container and all its dependencies.
-// Dependencies are started in the correct order before the application.
+// FromDockerfile builds the application image from a Dockerfile in a host
+// directory when the container is created, instead of using a prebuilt image.
 //
 // Parameters:
-//   - ctx: Context for the operation
+//   - contextDir: Host directory of the build context
+//   - dockerfile: Path of the Dockerfile within the context ("Dockerfile" if empty)
 //
 // Returns:
-//   - error: Any error that occurred during startup
+//   - *AppContainer: The same container for method chaining
 //
 // Example:
 //
-//	err := app.Start(context.Background())
-//	if err != nil {
-//	    log.Fatalf("Failed to start app: %v", err)
-//	}
-func (a *AppContainer) Start(ctx context.Context) error {
-	return a.impl.Start(ctx)
+//	app.FromDockerfile("../..", "build/Dockerfile")
+func (a *AppContainer) FromDockerfile(contextDir, dockerfile string) *AppContainer {
+	a.impl.Config().Build = &docker.BuildConfig{
+		Context:    contextDir,
+		Dockerfile: dockerfile,
+	}
+	return a
 }
 
-// Stop stops the application container and cleans up resources.
-// This should be called to ensure proper cleanup of the container.
+// FromDockerfileReader builds the application image from an in-memory build
+// context when the container is created, allowing images to be constructed
+// programmatically without touching the filesystem. The archive is read once
+// and reused if the container is recreated.
 //
 // Parameters:
-//   - ctx: Context for the operation
+//   - contextTar: Tar archive of the build context, including the Dockerfile
+//   - dockerfilePath: Path of the Dockerfile within the archive ("Dockerfile" if empty)
 //
 // Returns:
-//   - error: Any error that occurred during shutdown
+//   - *AppContainer: The same container for method chaining
 //
 // Example:
 //
-//	defer func() {
-//	    if err := app.Stop(context.Background()); err != nil {
-//	        log.Printf("Error stopping app: %v", err)
-//	    }
-//	}()
-func (a *AppContainer) Stop(ctx context.Context) error {
-	return a.impl.Stop(ctx)
+//	var buf bytes.Buffer
+//	tw := tar.NewWriter(&buf)
+//	// ... write Dockerfile and config files ...
+//	tw.Close()
+//	app.FromDockerfileReader(&buf, "Dockerfile")
+func (a *AppContainer) FromDockerfileReader(contextTar io.Reader, dockerfilePath string) *AppContainer {
+	a.impl.Config().Build = &docker.BuildConfig{
+		ContextArchive: contextTar,
+		Dockerfile:     dockerfilePath,
+	}
+	return a
 }
 
-// ID returns the unique identifier of the application container.
+// WithPlatform selects the image variant to pull for a multi-platform image,
+// e.g. to run the linux/arm64 variant on Apple Silicon rather than an amd64
+// one that fails with ErrArchMismatch.
+//
+// Parameters:
+//   - platform: The platform in os/arch[/variant] form (e.g. "linux/arm64")
 //
 // Returns:
-//   - string: The container ID
-func (a *AppContainer) ID() string {
-	return a.impl.ID()
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithPlatform(platform string) *AppContainer {
+	a.impl.Config().Platform = platform
+	return a
 }
 
-// Name returns the human-readable name of the application container.
+// WithName sets an explicit Docker container name, replacing the unique
+// generated default. Only use a stable name when the test needs one, as two
+// containers with the same name cannot run at the same time.
+//
+// Parameters:
+//   - name: The Docker container name
 //
 // Returns:
-//   - string: The container name
-func (a *AppContainer) Name() string {
-	return a.impl.Name()
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithName(name string) *AppContainer {
+	a.impl.Config().Name = name
+	return a
 }
 
-// Image returns the Docker image name used by the application container.
+// WithCapAdd adds Linux capabilities to the application container.
+// This allows testing runtime profiles that require elevated privileges,
+// such as binding to a privileged port.
+//
+// Parameters:
+//   - caps: Capability names to add (e.g. "NET_BIND_SERVICE")
 //
 // Returns:
-//   - string: The Docker image name
-func (a *AppContainer) Image() string {
-	return a.impl.Image()
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithCapAdd("NET_BIND_SERVICE")
+func (a *AppContainer) WithCapAdd(caps ...string) *AppContainer {
+	config := a.impl.Config()
+	config.CapAdd = append(config.CapAdd, caps...)
+	return a
 }
 
-// Host returns the host address where the application container is accessible.
+// WithCapDrop drops Linux capabilities from the application container.
+// This allows testing least-privilege runtime profiles.
+//
+// Parameters:
+//   - caps: Capability names to drop (e.g. "ALL")
 //
 // Returns:
-//   - string: The host address
-func (a *AppContainer) Host() string {
-	return a.impl.Host()
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithCapDrop("ALL").WithCapAdd("NET_BIND_SERVICE")
+func (a *AppContainer) WithCapDrop(caps ...string) *AppContainer {
+	config := a.impl.Config()
+	config.CapDrop = append(config.CapDrop, caps...)
+	return a
 }
 
-// Port returns the external port mapping for the specified internal port.
+// WithDNS sets the DNS servers the application container resolves names through,
+// instead of Docker's embedded DNS. This allows tests to provide their own resolver.
 //
 // Parameters:
-//   - internal: The internal port number
+//   - servers: DNS server IP addresses
 //
 // Returns:
-//   - int: The external port number
-//   - error: Any error that occurred
-func (a *AppContainer) Port(internal int) (int, error) {
-	return a.impl.Port(internal)
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithDNS("10.0.0.53").WithDNSSearch("test.internal")
+func (a *AppContainer) WithDNS(servers ...string) *AppContainer {
+	config := a.impl.Config()
+	config.DNS = append(config.DNS, servers...)
+	return a
 }
 
-// ConnectionString returns the connection string for accessing the application.
+// WithDNSSearch sets the DNS search domains of the application container.
+//
+// Parameters:
+//   - domains: DNS search domains
 //
 // Returns:
-//   - string: The connection string
-func (a *AppContainer) ConnectionString() string {
-	return a.impl.ConnectionString()
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithDNSSearch(domains ...string) *AppContainer {
+	config := a.impl.Config()
+	config.DNSSearch = append(config.DNSSearch, domains...)
+	return a
 }
 
-// IsRunning returns whether the application container is currently running.
+// WithDeviceRequest makes host devices available to the application container,
+// for skeleton components that depend on hardware such as GPUs.
+//
+// Parameters:
+//   - request: The device request
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithDeviceRequest(container.DeviceRequest{
+//	    Driver:       "nvidia",
+//	    Count:        1,
+//	    Capabilities: []string{"gpu"},
+//	})
+func (a *AppContainer) WithDeviceRequest(request DeviceRequest) *AppContainer {
+	config := a.impl.Config()
+	config.Devices = append(config.Devices, request)
+	return a
+}
+
+// WithUlimit sets a resource limit of the application container. This allows
+// load tests to reproduce production limits, such as a raised "nofile" limit
+// for connection-pool-heavy services.
+//
+// Parameters:
+//   - name: The ulimit name (e.g. "nofile")
+//   - soft: The soft limit
+//   - hard: The hard limit
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithUlimit("nofile", 65536, 65536)
+func (a *AppContainer) WithUlimit(name string, soft, hard int64) *AppContainer {
+	config := a.impl.Config()
+	config.Ulimits = append(config.Ulimits, Ulimit{Name: name, Soft: soft, Hard: hard})
+	return a
+}
+
+// WithLogDriver sets the Docker log driver of the application container, e.g.
+// "local" with size limits, or "none" for throwaway containers, to relieve
+// disk pressure on busy CI agents. The "none" driver discards the output, so
+// Logs, FollowLogs and the features built on them, such as log verification,
+// PipeLogsToT and failure dumps, return an error; Start also fails if log
+// consumers were registered.
+//
+// Parameters:
+//   - driver: The log driver name (e.g. "local", "none")
+//   - opts: Driver options, may be nil
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithLogDriver("local", map[string]string{"max-size": "10m", "max-file": "2"})
+func (a *AppContainer) WithLogDriver(driver string, opts map[string]string) *AppContainer {
+	config := a.impl.Config()
+	config.LogDriver = driver
+	config.LogDriverOptions = opts
+	return a
+}
+
+// WithVolume bind-mounts a host directory or file into the application
+// container, e.g. to provide per-test data. Both paths must be absolute,
+// otherwise Start and BuildRequest fail; testkit.TempDir creates a host
+// directory suited for it that is removed when the test completes.
+//
+// Parameters:
+//   - source: Absolute path on the host
+//   - target: Absolute path inside the container
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	dir := testkit.TempDir(t)
+//	app.WithVolume(dir, "/data")
+func (a *AppContainer) WithVolume(source, target string) *AppContainer {
+	config := a.impl.Config()
+	config.Volumes = append(config.Volumes, domaincontainer.VolumeMapping{
+		Source: source,
+		Target: target,
+	})
+	return a
+}
+
+// WithDockerSocket bind-mounts the host Docker socket (/var/run/docker.sock)
+// into the application container, read-only, for skeleton components that
+// orchestrate sibling containers. The group owning the socket on the host is
+// added to the container user so that non-root images can connect.
+//
+// Access to the Docker socket is equivalent to root access on the host: the
+// application can start privileged containers and mount any host path. The
+// read-only mount only prevents replacing the socket file, not API calls.
+// Only use it with trusted images, and clean up the containers the
+// application creates, as the testkit does not track them.
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithDockerSocket() *AppContainer {
+	config := a.impl.Config()
+	config.DockerSocket = true
+	config.DockerSocketWritable = false
+	return a
+}
+
+// WithWritableDockerSocket bind-mounts the host Docker socket like
+// WithDockerSocket, but read-write, for tools that require it. The same
+// security caveats apply.
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithWritableDockerSocket() *AppContainer {
+	config := a.impl.Config()
+	config.DockerSocket = true
+	config.DockerSocketWritable = true
+	return a
+}
+
+// WithPrivileged runs the application container in privileged mode, for
+// components that manage low-level resources, such as loopback devices or a
+// nested Docker daemon, and otherwise fail with permission errors.
+//
+// A privileged container has every capability and access to all host devices,
+// so a process escaping it controls the host. Use it rarely, only for tests
+// that need it, and prefer WithCapAdd or WithDeviceRequest when a narrower
+// grant suffices.
+//
+// Parameters:
+//   - privileged: Whether the container runs privileged
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithPrivileged(privileged bool) *AppContainer {
+	a.impl.Config().Privileged = privileged
+	return a
+}
+
+// WithReadOnlyRootFS mounts the application container's root filesystem
+// read-only, reproducing hardened production runtimes. Writes outside paths
+// declared with WithWritableTmpfsPath then fail, exposing code that writes
+// where it should not.
+//
+// Parameters:
+//   - readOnly: Whether the root filesystem is read-only
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithReadOnlyRootFS(true).WithWritableTmpfsPath("/tmp")
+func (a *AppContainer) WithReadOnlyRootFS(readOnly bool) *AppContainer {
+	a.impl.Config().ReadOnlyRootFS = readOnly
+	return a
+}
+
+// WithWritableTmpfsPath mounts a writable tmpfs at the given path of the
+// application container, carving out a writable area of a read-only root
+// filesystem.
+//
+// Parameters:
+//   - path: Absolute in-container path (e.g. "/tmp")
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithWritableTmpfsPath(path string) *AppContainer {
+	config := a.impl.Config()
+	config.TmpfsPaths = append(config.TmpfsPaths, path)
+	return a
+}
+
+// WithGPU makes NVIDIA GPUs available to the application container. This
+// requires a Docker daemon with the NVIDIA container runtime; use
+// testkit.SkipIfNoGPU to skip tests on hosts without one.
+//
+// Parameters:
+//   - count: Number of GPUs to request, or -1 for all available GPUs
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithGPU(count int) *AppContainer {
+	return a.WithDeviceRequest(DeviceRequest{
+		Driver:       "nvidia",
+		Count:        count,
+		Capabilities: []string{"gpu"},
+	})
+}
+
+// WithDockerHealthCheck configures Docker's native HEALTHCHECK for the application
+// container, so that `docker ps` and the container state API report its health.
+// This is independent of the testkit's own health monitoring.
+//
+// Parameters:
+//   - cmd: Health check command; a plain command is run via "CMD", while commands
+//     starting with "CMD", "CMD-SHELL" or "NONE" are passed unchanged
+//   - interval: Time between health check runs
+//   - timeout: Maximum time a single health check run may take
+//   - retries: Consecutive failures needed to report the container unhealthy
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithDockerHealthCheck([]string{"curl", "-f", "http://localhost:8080/health"},
+//	    2*time.Second, time.Second, 5).
+//	    WithWaitForHealthCheck()
+func (a *AppContainer) WithDockerHealthCheck(cmd []string, interval, timeout time.Duration, retries int) *AppContainer {
+	test := cmd
+	if len(cmd) == 0 || (cmd[0] != "CMD" && cmd[0] != "CMD-SHELL" && cmd[0] != "NONE") {
+		test = append([]string{"CMD"}, cmd...)
+	}
+
+	a.impl.Config().HealthCheck = &dockercontainer.HealthConfig{
+		Test:     test,
+		Interval: interval,
+		Timeout:  timeout,
+		Retries:  retries,
+	}
+	return a
+}
+
+// WithWaitForHealthCheck makes the application container's readiness depend on
+// Docker reporting it healthy. This requires a HEALTHCHECK, either from the
+// image or configured with WithDockerHealthCheck.
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithWaitForHealthCheck() *AppContainer {
+	config := a.impl.Config()
+	config.WaitStrategies = append(config.WaitStrategies, wait.ForHealthCheck())
+	return a
+}
+
+// WithReadyExec makes the application container's readiness depend on a
+// command run inside the container exiting 0, in addition to the default
+// readiness checks. Probing from inside the container validates in-container
+// networking, which catches setups where the host port mapping works but
+// routing inside the container does not.
+//
+// Parameters:
+//   - cmd: Command to run inside the container
+//   - interval: Time between command runs
+//   - timeout: Maximum time to wait for the command to succeed
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithReadyExec([]string{"curl", "-f", "http://localhost:8080/health"},
+//	    500*time.Millisecond, 30*time.Second)
+func (a *AppContainer) WithReadyExec(cmd []string, interval, timeout time.Duration) *AppContainer {
+	a.impl.AddReadyExec(cmd, interval, timeout)
+	return a
+}
+
+// WithWaitForLogQuiet makes the application container's readiness depend on
+// its log output settling: the container is ready once no new log line has
+// arrived for quietPeriod, in addition to the default readiness checks. This
+// is a heuristic for applications that log continuously during startup and
+// have no clear ready marker. It cannot be combined with WithLogDriver("none").
+//
+// Parameters:
+//   - quietPeriod: How long the logs must stay unchanged
+//   - timeout: Maximum time to wait for the logs to settle
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithWaitForLogQuiet(3*time.Second, time.Minute)
+func (a *AppContainer) WithWaitForLogQuiet(quietPeriod, timeout time.Duration) *AppContainer {
+	a.impl.AddLogQuietWait(quietPeriod, timeout)
+	return a
+}
+
+// WithFakeClock sets the time perceived by the application container when it
+// starts, for deterministic tests of scheduled or otherwise time-dependent
+// skeleton components. The clock keeps running from that time and can be
+// moved forward with AdvanceClock.
+//
+// This relies on libfaketime being installed in the image (the Debian
+// "faketime" package). Its path is derived from the architecture selected with
+// WithPlatform, or from the architecture of the test host, e.g.
+// /usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1 on arm64. Images of
+// another architecture, such as those run by a remote or emulating daemon, and
+// images installing libfaketime elsewhere need WithFakeClockLibrary; otherwise
+// the library is not loaded and the container sees the real time. Statically
+// linked binaries, such as Go binaries built with CGO_ENABLED=0, bypass
+// libfaketime and see the real time.
+//
+// Parameters:
+//   - start: The time the container perceives when it starts
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithFakeClock(time.Date(2024, 1, 1, 23, 59, 0, 0, time.UTC))
+func (a *AppContainer) WithFakeClock(start time.Time) *AppContainer {
+	a.impl.Config().SetFakeClock(docker.FakeClock{Start: start})
+	return a
+}
+
+// WithFakeClockLibrary sets the in-container path of libfaketime loaded for
+// WithFakeClock, replacing the path derived from the architecture.
+//
+// Parameters:
+//   - path: The path of libfaketime in the image
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithFakeClock(start).
+//	    WithFakeClockLibrary("/usr/local/lib/faketime/libfaketime.so.1")
+func (a *AppContainer) WithFakeClockLibrary(path string) *AppContainer {
+	a.impl.Config().FaketimeLibrary = path
+	return a
+}
+
+// WithReadyTimeout sets how long the application container may take to become
+// ready, overriding the default set with testkit.SetDefaultReadyTimeout.
+//
+// Parameters:
+//   - timeout: Maximum time to wait for readiness
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithReadyTimeout(timeout time.Duration) *AppContainer {
+	a.impl.Config().ReadyTimeout = timeout
+	return a
+}
+
+// ReadyTimeout returns how long the application container may take to become
+// ready: the timeout set with WithReadyTimeout, or the default set with
+// testkit.SetDefaultReadyTimeout. An application container waits this long
+// for the container when it is one of its dependencies.
+//
+// Returns:
+//   - time.Duration: The readiness timeout
+func (a *AppContainer) ReadyTimeout() time.Duration {
+	return a.impl.ReadyTimeout()
+}
+
+// WithKeepVolumes sets whether the application container's anonymous volumes
+// are kept when it is terminated, so that data can be inspected after the run.
+// By default they are removed along with the container. Note that the
+// testcontainers reaper still removes containers and their volumes when the
+// test process exits unless it is disabled.
+//
+// Parameters:
+//   - keep: Whether to keep the volumes
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithKeepVolumes(keep bool) *AppContainer {
+	a.impl.Config().KeepVolumes = keep
+	return a
+}
+
+// WithHTTPReadiness sets whether the application container is only considered
+// ready once its health endpoint responds with HTTP 200. This is enabled by
+// default, since an application can listen on its port before its routes are
+// mounted; disable it for images that do not serve the health endpoint.
+//
+// Parameters:
+//   - enabled: Whether readiness requires a healthy HTTP response
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+func (a *AppContainer) WithHTTPReadiness(enabled bool) *AppContainer {
+	a.impl.SetHTTPReadiness(enabled)
+	return a
+}
+
+// WithAnnotation attaches test metadata, such as a ticket number or scenario
+// description, to the application container. Annotations are not passed to
+// Docker; they are printed by DebugDump and attached to the context of
+// container errors returned by lifecycle operations.
+//
+// Parameters:
+//   - key: The annotation key
+//   - value: The annotation value
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithAnnotation("ticket", "PAY-1234").
+//	    WithAnnotation("scenario", "duplicate payment is rejected")
+func (a *AppContainer) WithAnnotation(key, value string) *AppContainer {
+	config := a.impl.Config()
+	if config.Annotations == nil {
+		config.Annotations = make(map[string]string)
+	}
+	config.Annotations[key] = value
+	return a
+}
+
+// WithHealthEndpoint sets the health check endpoint for the application.
+// This endpoint will be used for health monitoring and readiness checks.
+//
+// Parameters:
+//   - endpoint: The health check endpoint path
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithHealthEndpoint("/health")
+func (a *AppContainer) WithHealthEndpoint(endpoint string) *AppContainer {
+	return a.WithSkeletonContract(domaincontainer.SkeletonContract{HealthPath: endpoint})
+}
+
+// WithShutdownEndpoint sets the graceful shutdown endpoint for the application.
+// This endpoint can be used to trigger graceful shutdown of the application.
+//
+// Parameters:
+//   - endpoint: The shutdown endpoint path
+//
+// Returns:
+//   - *AppContainer: The same container for method chaining
+//
+// Example:
+//
+//	app.WithShutdownEndpoint("/shutdown")
+func (a *AppContainer) WithShutdownEndpoint(endpoint string) *AppContainer {
+	return a.WithSkeletonContract(domaincontainer.SkeletonContract{ShutdownPath: endpoint})
+}
+
+// Start starts the application container and all its dependencies.
+// Dependencies are started in the correct order before the application.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred during startup
+//
+// Example:
+//
+//	err := app.Start(context.Background())
+//	if err != nil {
+//	    log.Fatalf("Failed to start app: %v", err)
+//	}
+func (a *AppContainer) Start(ctx context.Context) error {
+	return a.annotate(a.impl.Start(ctx))
+}
+
+// Stop stops the application container and cleans up resources.
+// This should be called to ensure proper cleanup of the container.
+// Cleanup does not observe cancellation of ctx: it runs with a fresh context
+// bounded to 30 seconds, so that it still succeeds when called with a test
+// context that has already timed out.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred during shutdown
+//
+// Example:
+//
+//	defer func() {
+//	    if err := app.Stop(context.Background()); err != nil {
+//	        log.Printf("Error stopping app: %v", err)
+//	    }
+//	}()
+func (a *AppContainer) Stop(ctx context.Context) error {
+	return a.annotate(a.impl.Stop(ctx))
+}
+
+// StopOrder returns the dependencies stopped by the last Stop, in the order
+// they were stopped, with the time and outcome of each stop. Dependencies that
+// were not running are omitted.
+//
+// Returns:
+//   - []StopRecord: The dependency stops in order
+func (a *AppContainer) StopOrder() []StopRecord {
+	return a.impl.StopOrder()
+}
+
+// StartupMetrics returns the phase timings of the last Start. A failed start
+// reports the phases up to and including the one that failed.
+//
+// Returns:
+//   - StartupMetrics: The duration of each startup phase
+func (a *AppContainer) StartupMetrics() StartupMetrics {
+	return a.impl.StartupMetrics()
+}
+
+// AdvanceClock moves the fake clock of the running application container
+// forward, e.g. past midnight to trigger a scheduled job. The container must
+// have been configured with WithFakeClock; offsets have a resolution of one second.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - d: Duration to move the clock forward by
+//
+// Returns:
+//   - error: Any error that occurred while updating the clock
+//
+// Example:
+//
+//	err := app.AdvanceClock(ctx, 2*time.Minute)
+func (a *AppContainer) AdvanceClock(ctx context.Context, d time.Duration) error {
+	return a.annotate(a.impl.AdvanceClock(ctx, d))
+}
+
+// VerifyImageDigest verifies that the running application container uses the
+// image digest set with WithImageDigest, matching either the image ID or one
+// of its repository digests.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: An error if no digest is pinned, the image cannot be inspected, or the digest differs
+func (a *AppContainer) VerifyImageDigest(ctx context.Context) error {
+	digest := a.impl.Config().ImageDigest
+	if digest == "" {
+		return fmt.Errorf("container %s has no pinned image digest", a.ID())
+	}
+	return a.annotate(a.impl.VerifyImageDigest(ctx, digest))
+}
+
+// Terminate removes the application container and its dependencies along with
+// their anonymous volumes, unless WithKeepVolumes is set. Unlike Stop, the
+// containers are not kept for a later restart, so no disk usage lingers.
+// Like Stop, Terminate does not observe cancellation of ctx.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while removing the containers
+//
+// Example:
+//
+//	t.Cleanup(func() { _ = app.Terminate(context.Background()) })
+func (a *AppContainer) Terminate(ctx context.Context) error {
+	return a.annotate(a.impl.Terminate(ctx))
+}
+
+// Commit snapshots the running application container into a new image, so that
+// expensive setup (seeded data, warmed caches) can be reused by later tests via
+// NewSkeletonApp(imageRef).
+//
+// Only the container filesystem is captured: data in volumes, including
+// anonymous volumes declared by the image, is not part of the snapshot, and
+// in-memory process state is lost.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - imageRef: Reference to tag the new image with (e.g. "my-app:seeded")
+//
+// Returns:
+//   - error: Any error that occurred while committing the container
+func (a *AppContainer) Commit(ctx context.Context, imageRef string) error {
+	return a.annotate(a.impl.Commit(ctx, imageRef))
+}
+
+// Restart stops the application container and its dependencies and starts them
+// again, reusing the existing containers.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while stopping or starting
+func (a *AppContainer) Restart(ctx context.Context) error {
+	if err := a.impl.Stop(ctx); err != nil {
+		return a.annotate(err)
+	}
+	return a.annotate(a.impl.Start(ctx))
+}
+
+// Annotations returns the test metadata attached with WithAnnotation.
+//
+// Returns:
+//   - map[string]string: The annotations by key
+func (a *AppContainer) Annotations() map[string]string {
+	annotations := make(map[string]string, len(a.impl.Config().Annotations))
+	for key, value := range a.impl.Config().Annotations {
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// DebugDump writes a diagnostic report of the application container to w,
+// including its state, annotations, dependencies and logs. This is intended
+// for failure output when a test needs to explain what the container was doing.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - w: Writer receiving the report
+//
+// Returns:
+//   - error: Any error that occurred while writing the report
+//
+// Example:
+//
+//	if t.Failed() {
+//	    _ = app.DebugDump(context.Background(), os.Stderr)
+//	}
+func (a *AppContainer) DebugDump(ctx context.Context, w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "=== debug dump of container %s ===\n", a.Name())
+	fmt.Fprintf(&b, "ID: %s\n", a.ID())
+	fmt.Fprintf(&b, "Image: %s\n", a.Image())
+	fmt.Fprintf(&b, "Running: %t\n", a.IsRunning())
+	if connStr := a.ConnectionString(); connStr != "" {
+		fmt.Fprintf(&b, "Connection: %s\n", connStr)
+	}
+	if ports, err := a.Ports(ctx); err == nil && len(ports) > 0 {
+		internals := make([]int, 0, len(ports))
+		for internal := range ports {
+			internals = append(internals, internal)
+		}
+		sort.Ints(internals)
+
+		b.WriteString("Ports:\n")
+		for _, internal := range internals {
+			fmt.Fprintf(&b, "  %d -> %d\n", internal, ports[internal])
+		}
+	}
+
+	annotations := a.Annotations()
+	if len(annotations) > 0 {
+		keys := make([]string, 0, len(annotations))
+		for key := range annotations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("Annotations:\n")
+		for _, key := range keys {
+			fmt.Fprintf(&b, "  %s: %s\n", key, annotations[key])
+		}
+	}
+
+	if deps := a.Dependencies(); len(deps) > 0 {
+		b.WriteString("Dependencies:\n")
+		for _, dep := range deps {
+			fmt.Fprintf(&b, "  %s (%s) running=%t\n", dep.Name(), dep.Image(), dep.IsRunning())
+		}
+	}
+
+	b.WriteString("Logs:\n")
+	logs, err := a.Logs(ctx)
+	if err != nil {
+		fmt.Fprintf(&b, "  unavailable: %v\n", err)
+	} else {
+		content, err := io.ReadAll(logs)
+		if err != nil {
+			fmt.Fprintf(&b, "  unavailable: %v\n", err)
+		} else {
+			b.Write(content)
+		}
+	}
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// redactedValue replaces secrets in redacted environments
+const redactedValue = "[REDACTED]"
+
+var (
+	// secretEnvName matches names of environment variables holding secrets
+	secretEnvName = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|PRIVATE_?KEY|CREDENTIAL)`)
+	// urlPassword matches the password of credentials embedded in a URL
+	urlPassword = regexp.MustCompile(`(://[^:/@\s"]+:)[^@\s"]+@`)
+)
+
+// redactEnvironment returns a copy of env with secret values redacted
+func redactEnvironment(env map[string]string) map[string]string {
+	redacted := make(map[string]string, len(env))
+	for name, value := range env {
+		if secretEnvName.MatchString(name) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = urlPassword.ReplaceAllString(value, "${1}"+redactedValue+"@")
+	}
+	return redacted
+}
+
+// annotate attaches the container's annotations to the context of any
+// container errors contained in err
+func (a *AppContainer) annotate(err error) error {
+	return domaincontainer.AnnotateError(err, a.impl.Config().Annotations)
+}
+
+// ID returns the unique identifier of the application container.
+//
+// Returns:
+//   - string: The container ID
+func (a *AppContainer) ID() string {
+	return a.impl.ID()
+}
+
+// Name returns the human-readable name of the application container.
+//
+// Returns:
+//   - string: The container name
+func (a *AppContainer) Name() string {
+	return a.impl.Name()
+}
+
+// Image returns the Docker image name used by the application container.
+//
+// Returns:
+//   - string: The Docker image name
+func (a *AppContainer) Image() string {
+	return a.impl.Image()
+}
+
+// Validate is a pre-flight check that every configured image, of the
+// application and of each dependency, exists locally or can be resolved in
+// its registry, without starting any container. Calling it before Start fails
+// fast on a misconfigured topology, such as a typo in a dependency image,
+// instead of after the healthy dependencies have booted. An application image
+// built from a Dockerfile is not checked.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: An aggregated error listing every unavailable image, or nil
+//
+// Example:
+//
+//	if err := app.Validate(ctx); err != nil {
+//	    t.Fatal(err)
+//	}
+func (a *AppContainer) Validate(ctx context.Context) error {
+	return a.annotate(a.impl.ValidateImages(ctx))
+}
+
+// ValidateConfig checks the skeleton configuration of the application
+// container, with any WithComponentConfigOverride values applied, without
+// starting anything. It reports a missing service ID, missing or duplicate
+// plugin names, a storage URL without a type and overrides of unknown plugins.
+//
+// Returns:
+//   - error: An error wrapping ConfigErrors with every invalid field, or nil
+//
+// Example:
+//
+//	var cfgErrs container.ConfigErrors
+//	if err := app.ValidateConfig(); errors.As(err, &cfgErrs) {
+//	    for _, e := range cfgErrs {
+//	        t.Errorf("%s %s", e.Field, e.Reason)
+//	    }
+//	}
+func (a *AppContainer) ValidateConfig() error {
+	return a.annotate(a.impl.ValidateConfig())
+}
+
+// BuildRequest computes the testcontainers request the application container
+// is created with, including the merged environment, skeleton configuration
+// injection, exposed ports, wait strategies and mounts, without starting
+// anything or contacting Docker. This enables golden or snapshot tests of the
+// request. The per-process testkit labels, the container name prefix and the
+// labeled mounts for the volumes the image declares are added when the
+// container is created, so they are not part of the request. Dependency
+// connection strings reflect the current state of the dependencies, so they
+// are empty before Start.
+//
+// Returns:
+//   - tc.ContainerRequest: The request the container is created from
+//   - error: Any error that occurred while building the request
+//
+// Example:
+//
+//	req, err := app.BuildRequest()
+//	require.NoError(t, err)
+//	assert.Equal(t, "payments", req.Env["SKELETON_SERVICE_ID"])
+func (a *AppContainer) BuildRequest() (tc.ContainerRequest, error) {
+	req, err := a.impl.BuildRequest()
+	if err != nil {
+		return tc.ContainerRequest{}, a.annotate(err)
+	}
+	return req, nil
+}
+
+// Spec describes how the application container is run: its image or build,
+// resolved environment (including secrets) and container ports.
+//
+// Returns:
+//   - ContainerSpec: The container description
+//   - error: An error if the environment cannot be resolved or the image is
+//     built from an in-memory context
+func (a *AppContainer) Spec() (ContainerSpec, error) {
+	config := a.impl.Config()
+
+	env, err := a.impl.ResolvedEnvironment()
+	if err != nil {
+		return ContainerSpec{}, err
+	}
+
+	spec := ContainerSpec{
+		Image:       config.Image,
+		Environment: env,
+		Ports:       []int{a.impl.SkeletonContract().HTTPPort},
+	}
+	for _, port := range config.Ports {
+		if port.Internal != spec.Ports[0] {
+			spec.Ports = append(spec.Ports, port.Internal)
+		}
+	}
+
+	if config.Build != nil {
+		if config.Build.Context == "" {
+			return ContainerSpec{}, fmt.Errorf("application image is built from an in-memory context")
+		}
+		spec.Image = ""
+		spec.BuildContext = config.Build.Context
+		spec.Dockerfile = config.Build.Dockerfile
+	}
+
+	return spec, nil
+}
+
+// Host returns the host address where the application container is accessible.
+//
+// Returns:
+//   - string: The host address
+func (a *AppContainer) Host() string {
+	return a.impl.Host()
+}
+
+// Port returns the external port mapping for the specified internal port.
+//
+// Parameters:
+//   - internal: The internal port number
+//
+// Returns:
+//   - int: The external port number
+//   - error: Any error that occurred
+func (a *AppContainer) Port(internal int) (int, error) {
+	return a.impl.Port(internal)
+}
+
+// Ports returns every exposed internal port of the application container
+// mapped to its external port, inspecting the container once instead of once
+// per port as repeated Port calls do.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - map[int]int: External ports keyed by internal port
+//   - error: Any error that occurred, including when the container is not started
+//
+// Example:
+//
+//	ports, err := app.Ports(ctx)
+//	httpPort := ports[8080]
+func (a *AppContainer) Ports(ctx context.Context) (map[int]int, error) {
+	return a.impl.Ports(ctx)
+}
+
+// ConnectionString returns the connection string for accessing the application.
+//
+// Returns:
+//   - string: The connection string
+func (a *AppContainer) ConnectionString() string {
+	return a.impl.ConnectionString()
+}
+
+// IsRunning returns whether the application container is currently running.
 //
 // Returns:
 //   - bool: True if the container is running, false otherwise
@@ -335,6 +1606,14 @@ func (a *AppContainer) HealthEndpoint() string {
 	return a.impl.HealthEndpoint()
 }
 
+// SkeletonContract returns the HTTP port and endpoint paths the application exposes.
+//
+// Returns:
+//   - domaincontainer.SkeletonContract: The application's skeleton contract
+func (a *AppContainer) SkeletonContract() domaincontainer.SkeletonContract {
+	return a.impl.SkeletonContract()
+}
+
 // MetricsEndpoint returns the metrics endpoint URL for the application.
 //
 // Returns:
@@ -350,3 +1629,184 @@ func (a *AppContainer) MetricsEndpoint() string {
 func (a *AppContainer) ShutdownEndpoint() string {
 	return a.impl.ShutdownEndpoint()
 }
+
+// Logs returns the application container logs for debugging purposes.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - io.Reader: Reader for the container logs
+//   - error: Any error that occurred while retrieving logs
+func (a *AppContainer) Logs(ctx context.Context) (io.Reader, error) {
+	return a.impl.Logs(ctx)
+}
+
+// JoinNetwork attaches the application container to a user-defined network under the
+// given aliases, making it reachable by name from other containers on that
+// network. It must be called before the container is started.
+//
+// Parameters:
+//   - network: Name of the network to join
+//   - aliases: Host names the container is reachable under on the network
+func (a *AppContainer) JoinNetwork(network string, aliases ...string) {
+	a.impl.JoinNetwork(network, aliases...)
+}
+
+// Dependencies returns the containers the application depends on, in the
+// order they were added.
+//
+// Returns:
+//   - []domaincontainer.Container: The dependency containers
+func (a *AppContainer) Dependencies() []domaincontainer.Container {
+	return a.impl.Dependencies()
+}
+
+// ResolvedEnvironment returns the environment that is (or will be) passed to
+// the application container, including variables derived from the skeleton
+// configuration, with secrets redacted. Values of variables whose names suggest
+// secrets (e.g. *_PASSWORD, *_TOKEN) and passwords embedded in URLs are
+// replaced with "[REDACTED]", so the result is safe to log.
+//
+// Returns:
+//   - map[string]string: The redacted environment
+//   - error: Any error that occurred while resolving the environment
+//
+// Example:
+//
+//	env, err := app.ResolvedEnvironment()
+//	assert.Equal(t, "payments", env["SKELETON_SERVICE_ID"])
+func (a *AppContainer) ResolvedEnvironment() (map[string]string, error) {
+	env, err := a.impl.ResolvedEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	return redactEnvironment(env), nil
+}
+
+// ResolvedEnvironmentRaw returns the environment that is (or will be) passed to
+// the application container without redacting secrets. Avoid logging the result.
+//
+// Returns:
+//   - map[string]string: The environment
+//   - error: Any error that occurred while resolving the environment
+func (a *AppContainer) ResolvedEnvironmentRaw() (map[string]string, error) {
+	return a.impl.ResolvedEnvironment()
+}
+
+// DependencyInfo describes a dependency of an application container.
+type DependencyInfo struct {
+	Name  string
+	Image string
+	Type  string // "postgres", "redis" or "container"
+}
+
+// ContainerSpec describes how a container is run, independently of Docker
+// state, for exporting a setup to other tools such as docker compose.
+type ContainerSpec struct {
+	Image string
+	// BuildContext and Dockerfile are set instead of Image for containers
+	// built from a Dockerfile in a host directory
+	BuildContext string
+	Dockerfile   string
+	Environment  map[string]string
+	Command      []string
+	Ports        []int // Container ports
+}
+
+// DependencyType returns the kind of a dependency container: "postgres",
+// "redis" or, for other implementations, "container".
+func DependencyType(dep domaincontainer.Container) string {
+	switch dep.(type) {
+	case *PostgresContainer:
+		return "postgres"
+	case *RedisContainer:
+		return "redis"
+	default:
+		return "container"
+	}
+}
+
+// DependencyTopology describes the registered dependencies of the application
+// in start order, without requiring Docker. This lets tests assert that an
+// environment is wired as intended before starting it.
+//
+// Returns:
+//   - []DependencyInfo: The name, image and type of each dependency
+//
+// Example:
+//
+//	topology := app.DependencyTopology()
+//	assert.Equal(t, "postgres", topology[0].Type)
+func (a *AppContainer) DependencyTopology() []DependencyInfo {
+	deps := a.impl.Dependencies()
+	topology := make([]DependencyInfo, len(deps))
+	for i, dep := range deps {
+		topology[i] = DependencyInfo{
+			Name:  dep.Name(),
+			Image: dep.Image(),
+			Type:  DependencyType(dep),
+		}
+	}
+	return topology
+}
+
+// ReorderDependencies changes the order in which the application's dependencies
+// are started, for example to check that the application does not rely on a
+// particular startup order. It takes effect on the next Start.
+//
+// Parameters:
+//   - deps: The current dependencies in the new order
+//
+// Returns:
+//   - error: An error if deps is not a permutation of the current dependencies
+func (a *AppContainer) ReorderDependencies(deps []domaincontainer.Container) error {
+	current := a.impl.Dependencies()
+	if len(deps) != len(current) {
+		return fmt.Errorf("expected %d dependencies, got %d", len(current), len(deps))
+	}
+
+	remaining := make(map[domaincontainer.Container]int, len(current))
+	for _, dep := range current {
+		remaining[dep]++
+	}
+	for _, dep := range deps {
+		if remaining[dep] == 0 {
+			return fmt.Errorf("dependency %s is not a dependency of the application", dep.ID())
+		}
+		remaining[dep]--
+	}
+
+	reordered := make([]domaincontainer.Container, len(deps))
+	copy(reordered, deps)
+	a.impl.SetDependencies(reordered)
+	return nil
+}
+
+// FollowLogs registers a consumer that receives the application's log output
+// line by line while the container runs. Consumers registered before Start
+// begin receiving output once the container has started.
+//
+// Parameters:
+//   - consumer: Function invoked for every log line
+//
+// Returns:
+//   - error: Any error that occurred while attaching to a running container
+//
+// Example:
+//
+//	err := app.FollowLogs(func(stream, line string) {
+//	    log.Printf("[%s] %s", stream, line)
+//	})
+func (a *AppContainer) FollowLogs(consumer LogConsumer) error {
+	return a.impl.FollowLogs(consumer)
+}
+
+// StopFollowingLogs stops forwarding the application's log output to
+// consumers registered with FollowLogs.
+//
+// Returns:
+//   - error: Any error that occurred while stopping log forwarding
+func (a *AppContainer) StopFollowingLogs() error {
+	return a.impl.StopFollowingLogs()
+}
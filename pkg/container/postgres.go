@@ -5,10 +5,16 @@ import (
 	"io"
 	"time"
 
+	"github.com/testcontainers/testcontainers-go/wait"
+
 	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
 	"github.com/fintechain/skeleton-testkit/internal/infrastructure/testcontainers"
 )
 
+// ConnectionParams holds the resolved parts of a PostgreSQL connection, for
+// applications and drivers configured with discrete parameters.
+type ConnectionParams = testcontainers.ConnectionParams
+
 // PostgresContainer represents a PostgreSQL database container for testing.
 // It provides a clean interface for managing PostgreSQL containers used
 // as dependencies in skeleton-based application testing.
@@ -30,6 +36,192 @@ func NewPostgresContainer(impl *testcontainers.PostgresContainer) *PostgresConta
 	}
 }
 
+// WithName sets an explicit Docker container name, replacing the unique
+// generated default. Only use a stable name when the test needs one, as two
+// containers with the same name cannot run at the same time.
+//
+// Parameters:
+//   - name: The Docker container name
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithName(name string) *PostgresContainer {
+	p.impl.Config().Name = name
+	return p
+}
+
+// WithConnectionStringFormat sets how ConnectionString and InternalConnectionString
+// format the connection string, for applications that expect a JDBC URL or a
+// key/value DSN instead of the default postgres:// URL.
+//
+// Parameters:
+//   - format: Function building the connection string from its parts
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+//
+// Example:
+//
+//	postgres.WithConnectionStringFormat(func(host string, port int, db, user, pass string) string {
+//	    return fmt.Sprintf("host=%s port=%d dbname=%s user=%s password=%s", host, port, db, user, pass)
+//	})
+func (p *PostgresContainer) WithConnectionStringFormat(format func(host string, port int, db, user, pass string) string) *PostgresContainer {
+	p.impl.SetConnectionStringFormat(format)
+	return p
+}
+
+// WithInitScript adds a script run when the PostgreSQL container is first
+// started, for seeding schemas and data. Scripts (.sql, .sql.gz or .sh) are
+// copied into /docker-entrypoint-initdb.d and run in the order added.
+// A script that cannot be read makes Start fail.
+//
+// Parameters:
+//   - path: Host path of the script
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+//
+// Example:
+//
+//	postgres.WithInitScript("testdata/schema.sql").
+//	    WithInitScript("testdata/seed.sql")
+func (p *PostgresContainer) WithInitScript(path string) *PostgresContainer {
+	p.impl.AddInitScript(path)
+	return p
+}
+
+// WithUlimit sets a resource limit of the postgres container, e.g. a raised
+// "nofile" limit for connection-heavy load tests.
+//
+// Parameters:
+//   - name: The ulimit name (e.g. "nofile")
+//   - soft: The soft limit
+//   - hard: The hard limit
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithUlimit(name string, soft, hard int64) *PostgresContainer {
+	config := p.impl.Config()
+	config.Ulimits = append(config.Ulimits, Ulimit{Name: name, Soft: soft, Hard: hard})
+	return p
+}
+
+// WithReadyTimeout sets how long the PostgreSQL container may take to become
+// ready, overriding the default set with testkit.SetDefaultReadyTimeout.
+//
+// Parameters:
+//   - timeout: Maximum time to wait for readiness
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithReadyTimeout(timeout time.Duration) *PostgresContainer {
+	p.impl.Config().ReadyTimeout = timeout
+	return p
+}
+
+// ReadyTimeout returns how long the PostgreSQL container may take to become
+// ready: the timeout set with WithReadyTimeout, or the default set with
+// testkit.SetDefaultReadyTimeout. An application container waits this long
+// for the container when it is one of its dependencies.
+//
+// Returns:
+//   - time.Duration: The readiness timeout
+func (p *PostgresContainer) ReadyTimeout() time.Duration {
+	return p.impl.ReadyTimeout()
+}
+
+// WithReadyExec makes the PostgreSQL container's readiness depend on a command
+// run inside the container exiting 0, in addition to the default readiness
+// checks. Probing from inside the container also validates in-container
+// networking, which host port checks do not.
+//
+// Parameters:
+//   - cmd: Command to run inside the container
+//   - interval: Time between command runs
+//   - timeout: Maximum time to wait for the command to succeed
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+//
+// Example:
+//
+//	p.WithReadyExec([]string{"pg_isready", "-U", "testuser"}, time.Second, time.Minute)
+func (p *PostgresContainer) WithReadyExec(cmd []string, interval, timeout time.Duration) *PostgresContainer {
+	p.impl.AddReadyExec(cmd, interval, timeout)
+	return p
+}
+
+// WithWaitStrategy replaces the default readiness checks of the PostgreSQL
+// container, which wait for its port and its ready log message, with the given
+// strategy. Use it for custom images whose readiness the defaults do not
+// detect; calling it again adds further strategies that must all succeed.
+//
+// Parameters:
+//   - strategy: Readiness strategy to wait for
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+//
+// Example:
+//
+//	p.WithWaitStrategy(wait.ForExec([]string{"/healthcheck"}))
+func (p *PostgresContainer) WithWaitStrategy(strategy wait.Strategy) *PostgresContainer {
+	config := p.impl.Config()
+	config.WaitStrategies = append(config.WaitStrategies, strategy)
+	config.ReplaceDefaultWait = true
+	return p
+}
+
+// WithReadyLog sets the log line the PostgreSQL container's default readiness
+// check waits for, instead of "database system is ready to accept connections", for images
+// that log a different ready message. The custom line only needs to appear once,
+// whereas the stock message is awaited twice because the server restarts
+// after running the init scripts.
+//
+// Parameters:
+//   - substring: Text of the log line signalling readiness
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithReadyLog(substring string) *PostgresContainer {
+	p.impl.Config().ReadyLog = substring
+	return p
+}
+
+// WithVolume bind-mounts a host directory or file into the PostgreSQL
+// container, e.g. to provide seed data. Both paths must be absolute, otherwise
+// Start fails; testkit.TempDir creates a host directory suited for it that is
+// removed when the test completes.
+//
+// Parameters:
+//   - source: Absolute path on the host
+//   - target: Absolute path inside the container
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithVolume(source, target string) *PostgresContainer {
+	config := p.impl.Config()
+	config.Volumes = append(config.Volumes, domaincontainer.VolumeMapping{
+		Source: source,
+		Target: target,
+	})
+	return p
+}
+
+// WithKeepVolumes sets whether the PostgreSQL container's anonymous volumes are kept
+// when it is terminated, so that its data can be inspected after the run.
+// By default they are removed along with the container.
+//
+// Parameters:
+//   - keep: Whether to keep the volumes
+//
+// Returns:
+//   - *PostgresContainer: The same container for method chaining
+func (p *PostgresContainer) WithKeepVolumes(keep bool) *PostgresContainer {
+	p.impl.Config().KeepVolumes = keep
+	return p
+}
+
 // Start starts the PostgreSQL container.
 // This will pull the PostgreSQL image if needed and start the container.
 //
@@ -49,7 +241,8 @@ func (p *PostgresContainer) Start(ctx context.Context) error {
 	return p.impl.Start(ctx)
 }
 
-// Stop stops the PostgreSQL container and cleans up resources.
+// Stop stops the PostgreSQL container and cleans up resources. Its anonymous
+// volumes are kept so that a restart finds its data; Terminate removes them.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -60,6 +253,19 @@ func (p *PostgresContainer) Stop(ctx context.Context) error {
 	return p.impl.Stop(ctx)
 }
 
+// Terminate removes the PostgreSQL container along with its anonymous volumes,
+// unless WithKeepVolumes is set. Unlike Stop, the container is not kept for a
+// later restart.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while removing the container
+func (p *PostgresContainer) Terminate(ctx context.Context) error {
+	return p.impl.Terminate(ctx)
+}
+
 // IsRunning returns whether the PostgreSQL container is currently running.
 //
 // Returns:
@@ -92,6 +298,20 @@ func (p *PostgresContainer) Image() string {
 	return p.impl.Image()
 }
 
+// Spec describes how the PostgreSQL container is run: its image, environment
+// (including the password) and container port.
+//
+// Returns:
+//   - ContainerSpec: The container description
+//   - error: Always nil
+func (p *PostgresContainer) Spec() (ContainerSpec, error) {
+	return ContainerSpec{
+		Image:       p.impl.Image(),
+		Environment: p.impl.Config().Environment,
+		Ports:       []int{5432},
+	}, nil
+}
+
 // Host returns the host address where the PostgreSQL container is accessible.
 //
 // Returns:
@@ -112,6 +332,47 @@ func (p *PostgresContainer) Port(internal int) (int, error) {
 	return p.impl.Port(internal)
 }
 
+// InternalPort returns the port PostgreSQL listens on inside the container
+// (5432), which containers on the same user-defined network connect to. Use
+// PortMapping to get the host port it is published on.
+//
+// Returns:
+//   - int: The in-container port
+func (p *PostgresContainer) InternalPort() int {
+	return p.impl.InternalPort()
+}
+
+// PortMapping returns the host port an internal port is published on, which
+// tests connecting from the host use, as opposed to the internal port used
+// by containers on the same network.
+//
+// Parameters:
+//   - internal: The in-container port, e.g. InternalPort()
+//
+// Returns:
+//   - int: The external, host-mapped port
+//   - error: An error if the container is not started or the port is not published
+//
+// Example:
+//
+//	external, err := postgres.PortMapping(postgres.InternalPort())
+func (p *PostgresContainer) PortMapping(internal int) (int, error) {
+	return p.impl.Port(internal)
+}
+
+// Ports returns the full pairing of internal ports to their host-mapped
+// external ports.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - map[int]int: External ports keyed by internal port
+//   - error: Any error that occurred, including when the container is not started
+func (p *PostgresContainer) Ports(ctx context.Context) (map[int]int, error) {
+	return p.impl.Ports(ctx)
+}
+
 // ConnectionString returns the PostgreSQL connection string.
 // This can be used to connect to the PostgreSQL database from the application.
 //
@@ -126,6 +387,42 @@ func (p *PostgresContainer) ConnectionString() string {
 	return p.impl.ConnectionString()
 }
 
+// ConnectionParams returns the resolved host, port, user, password and database
+// of the PostgreSQL container, so tests can build whatever DSN or driver
+// configuration they need without parsing ConnectionString.
+//
+// Returns:
+//   - ConnectionParams: The connection parts; Host and Port are empty until the container is started
+//
+// Example:
+//
+//	params := postgres.ConnectionParams()
+//	dsn := fmt.Sprintf("host=%s port=%d user=%s", params.Host, params.Port, params.User)
+func (p *PostgresContainer) ConnectionParams() ConnectionParams {
+	return p.impl.ConnectionParams()
+}
+
+// InternalConnectionString returns the PostgreSQL connection string for use by
+// containers on the same user-defined network, addressing the container by its
+// network alias and internal port.
+//
+// Returns:
+//   - string: The in-network connection string, or empty if the container has no network alias
+func (p *PostgresContainer) InternalConnectionString() string {
+	return p.impl.InternalConnectionString()
+}
+
+// JoinNetwork attaches the PostgreSQL container to a user-defined network under the
+// given aliases, making it reachable by name from other containers on that
+// network. It must be called before the container is started.
+//
+// Parameters:
+//   - network: Name of the network to join
+//   - aliases: Host names the container is reachable under on the network
+func (p *PostgresContainer) JoinNetwork(network string, aliases ...string) {
+	p.impl.JoinNetwork(network, aliases...)
+}
+
 // WaitForReady waits for the PostgreSQL container to be ready to accept connections.
 //
 // Parameters:
@@ -197,5 +494,91 @@ func (p *PostgresContainer) Exec(ctx context.Context, cmd []string) error {
 	return p.impl.Exec(ctx, cmd)
 }
 
+// Reset returns the database to a clean state by dropping and recreating its
+// public schema. This allows one PostgreSQL container to be shared by many
+// isolated sub-tests instead of recreating the container per test.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while resetting the database
+//
+// Example:
+//
+//	t.Cleanup(func() { _ = postgres.Reset(context.Background()) })
+func (p *PostgresContainer) Reset(ctx context.Context) error {
+	return p.impl.Reset(ctx)
+}
+
+// Snapshot dumps the current state of the database inside the container, e.g.
+// after applying migrations and seed data during setup, so that it can be
+// restored by ResetToSnapshot.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while dumping the database
+func (p *PostgresContainer) Snapshot(ctx context.Context) error {
+	return p.impl.Snapshot(ctx)
+}
+
+// ResetToSnapshot recreates the database and restores the state captured by
+// Snapshot. Open connections to the database are terminated, so this requires
+// PostgreSQL 13 or later.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred, including when no snapshot has been taken
+//
+// Example:
+//
+//	_ = postgres.Snapshot(ctx) // after seeding
+//	// ... run a sub-test ...
+//	_ = postgres.ResetToSnapshot(ctx)
+func (p *PostgresContainer) ResetToSnapshot(ctx context.Context) error {
+	return p.impl.ResetToSnapshot(ctx)
+}
+
+// WaitForRows polls the database until the query returns the expected number
+// of rows, for verifying eventually consistent writes triggered through the
+// application. The query is run with psql inside the container.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - query: SELECT query whose rows are counted
+//   - expectedCount: Number of rows to wait for
+//   - timeout: Maximum time to wait
+//
+// Returns:
+//   - error: Any error that occurred, including the last row count on timeout
+//
+// Example:
+//
+//	err := postgres.WaitForRows(ctx, "SELECT id FROM payments WHERE status = 'settled'", 1, 10*time.Second)
+func (p *PostgresContainer) WaitForRows(ctx context.Context, query string, expectedCount int, timeout time.Duration) error {
+	return p.impl.WaitForRows(ctx, query, expectedCount, timeout)
+}
+
+// Commit snapshots the running PostgreSQL container into a new image, so that
+// a seeded database can be reused by later tests.
+//
+// The stock postgres image stores its data in a volume at /var/lib/postgresql/data,
+// which is not captured by a commit. To snapshot data, configure PGDATA to a
+// path outside the volume (e.g. /pgdata) before seeding.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - imageRef: Reference to tag the new image with (e.g. "postgres:seeded")
+//
+// Returns:
+//   - error: Any error that occurred while committing the container
+func (p *PostgresContainer) Commit(ctx context.Context, imageRef string) error {
+	return p.impl.Commit(ctx, imageRef)
+}
+
 // Ensure PostgresContainer implements the Container interface
 var _ domaincontainer.Container = (*PostgresContainer)(nil)
@@ -5,6 +5,8 @@ import (
 	"io"
 	"time"
 
+	"github.com/testcontainers/testcontainers-go/wait"
+
 	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
 	"github.com/fintechain/skeleton-testkit/internal/infrastructure/testcontainers"
 )
@@ -30,6 +32,150 @@ func NewRedisContainer(impl *testcontainers.RedisContainer) *RedisContainer {
 	}
 }
 
+// WithName sets an explicit Docker container name, replacing the unique
+// generated default. Only use a stable name when the test needs one, as two
+// containers with the same name cannot run at the same time.
+//
+// Parameters:
+//   - name: The Docker container name
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithName(name string) *RedisContainer {
+	r.impl.Config().Name = name
+	return r
+}
+
+// WithUlimit sets a resource limit of the redis container, e.g. a raised
+// "nofile" limit for connection-heavy load tests.
+//
+// Parameters:
+//   - name: The ulimit name (e.g. "nofile")
+//   - soft: The soft limit
+//   - hard: The hard limit
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithUlimit(name string, soft, hard int64) *RedisContainer {
+	config := r.impl.Config()
+	config.Ulimits = append(config.Ulimits, Ulimit{Name: name, Soft: soft, Hard: hard})
+	return r
+}
+
+// WithReadyTimeout sets how long the Redis container may take to become
+// ready, overriding the default set with testkit.SetDefaultReadyTimeout.
+//
+// Parameters:
+//   - timeout: Maximum time to wait for readiness
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithReadyTimeout(timeout time.Duration) *RedisContainer {
+	r.impl.Config().ReadyTimeout = timeout
+	return r
+}
+
+// ReadyTimeout returns how long the Redis container may take to become
+// ready: the timeout set with WithReadyTimeout, or the default set with
+// testkit.SetDefaultReadyTimeout. An application container waits this long
+// for the container when it is one of its dependencies.
+//
+// Returns:
+//   - time.Duration: The readiness timeout
+func (r *RedisContainer) ReadyTimeout() time.Duration {
+	return r.impl.ReadyTimeout()
+}
+
+// WithReadyExec makes the Redis container's readiness depend on a command
+// run inside the container exiting 0, in addition to the default readiness
+// checks. Probing from inside the container also validates in-container
+// networking, which host port checks do not.
+//
+// Parameters:
+//   - cmd: Command to run inside the container
+//   - interval: Time between command runs
+//   - timeout: Maximum time to wait for the command to succeed
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+//
+// Example:
+//
+//	r.WithReadyExec([]string{"redis-cli", "ping"}, time.Second, time.Minute)
+func (r *RedisContainer) WithReadyExec(cmd []string, interval, timeout time.Duration) *RedisContainer {
+	r.impl.AddReadyExec(cmd, interval, timeout)
+	return r
+}
+
+// WithWaitStrategy replaces the default readiness checks of the Redis
+// container, which wait for its port and its ready log message, with the given
+// strategy. Use it for custom images whose readiness the defaults do not
+// detect; calling it again adds further strategies that must all succeed.
+//
+// Parameters:
+//   - strategy: Readiness strategy to wait for
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+//
+// Example:
+//
+//	r.WithWaitStrategy(wait.ForExec([]string{"/healthcheck"}))
+func (r *RedisContainer) WithWaitStrategy(strategy wait.Strategy) *RedisContainer {
+	config := r.impl.Config()
+	config.WaitStrategies = append(config.WaitStrategies, strategy)
+	config.ReplaceDefaultWait = true
+	return r
+}
+
+// WithReadyLog sets the log line the Redis container's default readiness
+// check waits for, instead of "Ready to accept connections", for images
+// that log a different ready message.
+//
+// Parameters:
+//   - substring: Text of the log line signalling readiness
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithReadyLog(substring string) *RedisContainer {
+	r.impl.Config().ReadyLog = substring
+	return r
+}
+
+// WithVolume bind-mounts a host directory or file into the Redis
+// container, e.g. to provide seed data. Both paths must be absolute, otherwise
+// Start fails; testkit.TempDir creates a host directory suited for it that is
+// removed when the test completes.
+//
+// Parameters:
+//   - source: Absolute path on the host
+//   - target: Absolute path inside the container
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithVolume(source, target string) *RedisContainer {
+	config := r.impl.Config()
+	config.Volumes = append(config.Volumes, domaincontainer.VolumeMapping{
+		Source: source,
+		Target: target,
+	})
+	return r
+}
+
+// WithKeepVolumes sets whether the Redis container's anonymous volumes are kept
+// when it is terminated, so that its data can be inspected after the run.
+// By default they are removed along with the container.
+//
+// Parameters:
+//   - keep: Whether to keep the volumes
+//
+// Returns:
+//   - *RedisContainer: The same container for method chaining
+func (r *RedisContainer) WithKeepVolumes(keep bool) *RedisContainer {
+	r.impl.Config().KeepVolumes = keep
+	return r
+}
+
 // Start starts the Redis container.
 // This will pull the Redis image if needed and start the container.
 //
@@ -49,7 +195,8 @@ func (r *RedisContainer) Start(ctx context.Context) error {
 	return r.impl.Start(ctx)
 }
 
-// Stop stops the Redis container and cleans up resources.
+// Stop stops the Redis container and cleans up resources. Its anonymous
+// volumes are kept so that a restart finds its data; Terminate removes them.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -60,6 +207,19 @@ func (r *RedisContainer) Stop(ctx context.Context) error {
 	return r.impl.Stop(ctx)
 }
 
+// Terminate removes the Redis container along with its anonymous volumes,
+// unless WithKeepVolumes is set. Unlike Stop, the container is not kept for a
+// later restart.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while removing the container
+func (r *RedisContainer) Terminate(ctx context.Context) error {
+	return r.impl.Terminate(ctx)
+}
+
 // IsRunning returns whether the Redis container is currently running.
 //
 // Returns:
@@ -92,6 +252,21 @@ func (r *RedisContainer) Image() string {
 	return r.impl.Image()
 }
 
+// Spec describes how the Redis container is run: its image, environment,
+// server command (including the password) and container port.
+//
+// Returns:
+//   - ContainerSpec: The container description
+//   - error: Always nil
+func (r *RedisContainer) Spec() (ContainerSpec, error) {
+	return ContainerSpec{
+		Image:       r.impl.Image(),
+		Environment: r.impl.Config().Environment,
+		Command:     r.impl.Command(),
+		Ports:       []int{6379},
+	}, nil
+}
+
 // Host returns the host address where the Redis container is accessible.
 //
 // Returns:
@@ -112,6 +287,47 @@ func (r *RedisContainer) Port(internal int) (int, error) {
 	return r.impl.Port(internal)
 }
 
+// InternalPort returns the port Redis listens on inside the container
+// (6379), which containers on the same user-defined network connect to. Use
+// PortMapping to get the host port it is published on.
+//
+// Returns:
+//   - int: The in-container port
+func (r *RedisContainer) InternalPort() int {
+	return r.impl.InternalPort()
+}
+
+// PortMapping returns the host port an internal port is published on, which
+// tests connecting from the host use, as opposed to the internal port used
+// by containers on the same network.
+//
+// Parameters:
+//   - internal: The in-container port, e.g. InternalPort()
+//
+// Returns:
+//   - int: The external, host-mapped port
+//   - error: An error if the container is not started or the port is not published
+//
+// Example:
+//
+//	external, err := redis.PortMapping(redis.InternalPort())
+func (r *RedisContainer) PortMapping(internal int) (int, error) {
+	return r.impl.Port(internal)
+}
+
+// Ports returns the full pairing of internal ports to their host-mapped
+// external ports.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - map[int]int: External ports keyed by internal port
+//   - error: Any error that occurred, including when the container is not started
+func (r *RedisContainer) Ports(ctx context.Context) (map[int]int, error) {
+	return r.impl.Ports(ctx)
+}
+
 // ConnectionString returns the Redis connection string.
 // This can be used to connect to the Redis cache from the application.
 //
@@ -126,6 +342,27 @@ func (r *RedisContainer) ConnectionString() string {
 	return r.impl.ConnectionString()
 }
 
+// InternalConnectionString returns the Redis connection string for use by
+// containers on the same user-defined network, addressing the container by its
+// network alias and internal port.
+//
+// Returns:
+//   - string: The in-network connection string, or empty if the container has no network alias
+func (r *RedisContainer) InternalConnectionString() string {
+	return r.impl.InternalConnectionString()
+}
+
+// JoinNetwork attaches the Redis container to a user-defined network under the
+// given aliases, making it reachable by name from other containers on that
+// network. It must be called before the container is started.
+//
+// Parameters:
+//   - network: Name of the network to join
+//   - aliases: Host names the container is reachable under on the network
+func (r *RedisContainer) JoinNetwork(network string, aliases ...string) {
+	r.impl.JoinNetwork(network, aliases...)
+}
+
 // WaitForReady waits for the Redis container to be ready to accept connections.
 //
 // Parameters:
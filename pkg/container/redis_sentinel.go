@@ -0,0 +1,128 @@
+package container
+
+import (
+	"context"
+	"time"
+
+	"github.com/fintechain/skeleton-testkit/internal/infrastructure/testcontainers"
+)
+
+// RedisSentinelCluster represents a Redis primary with replicas monitored by
+// sentinels, for testing failover-aware skeleton components. All nodes run on
+// a dedicated network and announce their network aliases, so the primary and
+// replica addresses the sentinels report are only reachable from containers
+// that join NetworkName().
+type RedisSentinelCluster struct {
+	impl *testcontainers.RedisSentinelCluster
+}
+
+// NewRedisSentinelCluster creates a new RedisSentinelCluster wrapper around the internal implementation.
+//
+// Parameters:
+//   - impl: The internal RedisSentinelCluster implementation
+//
+// Returns:
+//   - *RedisSentinelCluster: A new RedisSentinelCluster wrapper
+func NewRedisSentinelCluster(impl *testcontainers.RedisSentinelCluster) *RedisSentinelCluster {
+	return &RedisSentinelCluster{
+		impl: impl,
+	}
+}
+
+// Start creates the cluster network, starts the primary, the replicas and the
+// sentinels, and waits until every sentinel sees the primary along with all
+// replicas and the other sentinels.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred while starting the topology
+//
+// Example:
+//
+//	cluster := testkit.NewRedisSentinelCluster(testkit.RedisSentinelConfig{Replicas: 2, Sentinels: 3})
+//	if err := cluster.Start(ctx); err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer cluster.Terminate(ctx)
+func (c *RedisSentinelCluster) Start(ctx context.Context) error {
+	return c.impl.Start(ctx)
+}
+
+// WaitForReady waits until every sentinel reports the primary as healthy with
+// all replicas and the other sentinels discovered, e.g. after a failover.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - timeout: Maximum time to wait
+//
+// Returns:
+//   - error: An error if the topology is not healthy within timeout
+func (c *RedisSentinelCluster) WaitForReady(ctx context.Context, timeout time.Duration) error {
+	return c.impl.WaitForReady(ctx, timeout)
+}
+
+// Terminate removes every node of the cluster and its network. Cleanup still
+// runs if ctx has already been cancelled.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//
+// Returns:
+//   - error: Any error that occurred during removal
+func (c *RedisSentinelCluster) Terminate(ctx context.Context) error {
+	return c.impl.Terminate(ctx)
+}
+
+// MasterName returns the name the sentinels monitor the primary under, as
+// passed to sentinel-aware clients.
+//
+// Returns:
+//   - string: The master name
+func (c *RedisSentinelCluster) MasterName() string {
+	return c.impl.MasterName()
+}
+
+// NetworkName returns the name of the cluster network, which an application
+// container joins to reach the nodes.
+//
+// Returns:
+//   - string: The network name
+func (c *RedisSentinelCluster) NetworkName() string {
+	return c.impl.NetworkName()
+}
+
+// SentinelEndpoints returns the host addresses of the sentinels.
+//
+// Returns:
+//   - []string: The sentinel addresses as host:port
+func (c *RedisSentinelCluster) SentinelEndpoints() []string {
+	return c.impl.SentinelEndpoints()
+}
+
+// InternalSentinelEndpoints returns the addresses of the sentinels on the
+// cluster network, for application containers that joined it.
+//
+// Returns:
+//   - []string: The sentinel addresses as alias:port
+//
+// Example:
+//
+//	app.JoinNetwork(cluster.NetworkName())
+//	app.WithEnvironment(map[string]string{
+//	    "REDIS_SENTINELS":   strings.Join(cluster.InternalSentinelEndpoints(), ","),
+//	    "REDIS_MASTER_NAME": cluster.MasterName(),
+//	})
+func (c *RedisSentinelCluster) InternalSentinelEndpoints() []string {
+	return c.impl.InternalSentinelEndpoints()
+}
+
+// PrimaryEndpoint returns the host address of the initial primary, for
+// seeding data or stopping it to trigger a failover.
+//
+// Returns:
+//   - string: The primary address as host:port
+func (c *RedisSentinelCluster) PrimaryEndpoint() string {
+	return c.impl.PrimaryEndpoint()
+}
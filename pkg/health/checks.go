@@ -4,9 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
+// targetURL returns the URL of an endpoint of the target. Paths are resolved
+// against the target's current connection string on every call, so that checks
+// keep working when a restart maps the target to a new host port; absolute
+// URLs are returned unchanged.
+func targetURL(target HealthTarget, endpoint string) (string, error) {
+	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
+		return endpoint, nil
+	}
+
+	baseURL := target.ConnectionString()
+	if baseURL == "" {
+		return "", fmt.Errorf("target is not reachable: no connection string")
+	}
+	return baseURL + endpoint, nil
+}
+
 // HTTPHealthCheck performs HTTP-based health checks
 type HTTPHealthCheck struct {
 	name     string
@@ -16,7 +33,9 @@ type HTTPHealthCheck struct {
 	client   *http.Client
 }
 
-// NewHTTPHealthCheck creates a new HTTP health check
+// NewHTTPHealthCheck creates a new HTTP health check of the given endpoint, a
+// path of the target or an absolute URL; an empty endpoint checks the
+// target's health endpoint
 func NewHTTPHealthCheck(name, endpoint string) *HTTPHealthCheck {
 	return &HTTPHealthCheck{
 		name:     name,
@@ -36,9 +55,17 @@ func (h *HTTPHealthCheck) Name() string {
 
 // Check performs the health check
 func (h *HTTPHealthCheck) Check(ctx context.Context, target HealthTarget) error {
-	url := target.HealthEndpoint()
+	endpoint := target.HealthEndpoint()
 	if h.endpoint != "" {
-		url = h.endpoint
+		endpoint = h.endpoint
+	}
+	if endpoint == "" {
+		return fmt.Errorf("health endpoint not configured")
+	}
+
+	url, err := targetURL(target, endpoint)
+	if err != nil {
+		return err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -109,8 +136,15 @@ func (s *SkeletonSystemHealthCheck) Name() string {
 
 // Check performs the skeleton system health check
 func (s *SkeletonSystemHealthCheck) Check(ctx context.Context, target HealthTarget) error {
+	if target.HealthEndpoint() == "" {
+		return fmt.Errorf("health endpoint not configured")
+	}
+
 	// Check the skeleton system service endpoint
-	url := fmt.Sprintf("%s/skeleton/system", target.HealthEndpoint())
+	url, err := targetURL(target, target.HealthEndpoint()+"/skeleton/system")
+	if err != nil {
+		return err
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -169,8 +203,15 @@ func (s *SkeletonComponentHealthCheck) Name() string {
 
 // Check performs the skeleton component health check
 func (s *SkeletonComponentHealthCheck) Check(ctx context.Context, target HealthTarget) error {
+	if target.HealthEndpoint() == "" {
+		return fmt.Errorf("health endpoint not configured")
+	}
+
 	// Check the skeleton component status endpoint
-	url := fmt.Sprintf("%s/skeleton/components/%s/status", target.HealthEndpoint(), s.componentID)
+	url, err := targetURL(target, fmt.Sprintf("%s/skeleton/components/%s/status", target.HealthEndpoint(), s.componentID))
+	if err != nil {
+		return err
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -5,11 +5,15 @@ package health
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
-// HealthTarget represents what is being health checked
+// HealthTarget represents what is being health checked. Checks call its
+// methods on every cycle, so a target whose address changes, e.g. after a
+// restart, is followed rather than checked at a stale address.
 type HealthTarget interface {
 	HealthEndpoint() string
 	ConnectionString() string
@@ -47,18 +51,29 @@ type CheckResult struct {
 	Timestamp time.Time     `json:"timestamp"`
 }
 
+// targetCheck binds a health check to the target it verifies
+type targetCheck struct {
+	key    string
+	target HealthTarget
+	check  HealthCheck
+}
+
 // HealthMonitor provides health monitoring capabilities
 type HealthMonitor struct {
-	target   HealthTarget
-	checks   []HealthCheck
-	interval time.Duration
-	status   HealthStatus
-	mutex    sync.RWMutex
-	stopCh   chan struct{}
-	running  bool
+	target       HealthTarget
+	checks       []HealthCheck
+	targetChecks []targetCheck
+	interval     time.Duration
+	status       HealthStatus
+	mutex        sync.RWMutex
+	stopCh       chan struct{}
+	running      bool
+	subscribers  map[int]chan HealthStatus
+	nextSubID    int
 }
 
-// NewHealthMonitor creates a new HealthMonitor for the given target
+// NewHealthMonitor creates a new HealthMonitor for the given target.
+// The target may be nil if the monitor only runs checks added with AddTargetCheck.
 func NewHealthMonitor(target HealthTarget) *HealthMonitor {
 	return &HealthMonitor{
 		target:   target,
@@ -69,7 +84,8 @@ func NewHealthMonitor(target HealthTarget) *HealthMonitor {
 			Checks:    make(map[string]CheckResult),
 			Timestamp: time.Now(),
 		},
-		stopCh: make(chan struct{}),
+		stopCh:      make(chan struct{}),
+		subscribers: make(map[int]chan HealthStatus),
 	}
 }
 
@@ -82,6 +98,30 @@ func (h *HealthMonitor) AddCheck(check HealthCheck) *HealthMonitor {
 	return h
 }
 
+// AddTargetCheck adds a health check against a specific target, allowing a single
+// monitor to track several targets (e.g. the replicas of a cluster). The result is
+// reported in HealthStatus.Checks under the key "<target>/<check>", where the
+// target is identified by its ID, or its connection string if it has no ID.
+func (h *HealthMonitor) AddTargetCheck(target HealthTarget, check HealthCheck) *HealthMonitor {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.targetChecks = append(h.targetChecks, targetCheck{
+		key:    fmt.Sprintf("%s/%s", targetKey(target), check.Name()),
+		target: target,
+		check:  check,
+	})
+	return h
+}
+
+// targetKey returns a stable identifier for a health target
+func targetKey(target HealthTarget) string {
+	if identified, ok := target.(interface{ ID() string }); ok {
+		return identified.ID()
+	}
+	return target.ConnectionString()
+}
+
 // Start starts the health monitoring
 func (h *HealthMonitor) Start(ctx context.Context) error {
 	h.mutex.Lock()
@@ -123,28 +163,124 @@ func (h *HealthMonitor) Status() HealthStatus {
 	return h.status
 }
 
+// Refresh runs every check once, synchronously and independently of the
+// monitoring loop, and returns the fresh status, e.g. to re-evaluate right
+// after a dependency has been unpaused instead of waiting for the next tick.
+// Subscribers receive the status as well.
+func (h *HealthMonitor) Refresh(ctx context.Context) HealthStatus {
+	return h.runHealthChecks(ctx)
+}
+
 // WaitForHealthy waits for the target to become healthy within the timeout
 func (h *HealthMonitor) WaitForHealthy(ctx context.Context, timeout time.Duration) error {
+	_, err := h.WaitForHealthyStatus(ctx, timeout)
+	return err
+}
+
+// WaitForHealthyStatus waits for the target to become healthy within the timeout
+// and returns the last health status, both on success and on timeout. The
+// timeout error names the checks that were still failing.
+//
+// The checks are run once immediately; after that the status is taken from
+// the monitoring loop when the monitor is running, or from checks run every
+// second otherwise.
+func (h *HealthMonitor) WaitForHealthyStatus(ctx context.Context, timeout time.Duration) (HealthStatus, error) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	updates, unsubscribe := h.Subscribe()
+	defer unsubscribe()
+
+	status := h.runHealthChecks(ctx)
+	if status.Overall == StatusHealthy {
+		return status, nil
+	}
+
+	// Drive the checks ourselves if no monitoring loop publishes updates
+	var tick <-chan time.Time
+	h.mutex.RLock()
+	running := h.running
+	h.mutex.RUnlock()
+	if !running {
+		ticker := time.NewTicker(1 * time.Second)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
 
 	for {
 		select {
 		case <-timeoutCtx.Done():
-			return fmt.Errorf("timeout waiting for healthy status")
-		case <-ticker.C:
+			if failing := failingChecks(status); failing != "" {
+				return status, fmt.Errorf("timeout waiting for healthy status: failing checks: %s", failing)
+			}
+			return status, fmt.Errorf("timeout waiting for healthy status")
+		case <-tick:
 			h.runHealthChecks(ctx)
-			status := h.Status()
+		case status = <-updates:
 			if status.Overall == StatusHealthy {
-				return nil
+				return status, nil
 			}
 		}
 	}
 }
 
+// Subscribe returns a channel receiving the health status computed by every
+// check cycle, and a function ending the subscription. The channel holds only
+// the latest status: a slow receiver skips intermediate statuses rather than
+// blocking the monitor.
+func (h *HealthMonitor) Subscribe() (<-chan HealthStatus, func()) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	id := h.nextSubID
+	h.nextSubID++
+
+	updates := make(chan HealthStatus, 1)
+	h.subscribers[id] = updates
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mutex.Lock()
+			defer h.mutex.Unlock()
+
+			delete(h.subscribers, id)
+			close(updates)
+		})
+	}
+
+	return updates, unsubscribe
+}
+
+// publish sends the status to every subscriber, replacing any status they
+// have not received yet. The caller must hold the mutex.
+func (h *HealthMonitor) publish(status HealthStatus) {
+	for _, updates := range h.subscribers {
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- status
+	}
+}
+
+// failingChecks describes the unhealthy checks of a status, sorted by name
+func failingChecks(status HealthStatus) string {
+	names := make([]string, 0, len(status.Checks))
+	for name, result := range status.Checks {
+		if result.Status != StatusHealthy {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	failing := make([]string, len(names))
+	for i, name := range names {
+		failing[i] = fmt.Sprintf("%s (%s)", name, status.Checks[name].Error)
+	}
+	return strings.Join(failing, ", ")
+}
+
 // monitoringLoop runs the health monitoring loop
 func (h *HealthMonitor) monitoringLoop(ctx context.Context) {
 	ticker := time.NewTicker(h.interval)
@@ -162,8 +298,8 @@ func (h *HealthMonitor) monitoringLoop(ctx context.Context) {
 	}
 }
 
-// runHealthChecks executes all health checks and updates status
-func (h *HealthMonitor) runHealthChecks(ctx context.Context) {
+// runHealthChecks executes all health checks, updates status and returns it
+func (h *HealthMonitor) runHealthChecks(ctx context.Context) HealthStatus {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
@@ -171,7 +307,7 @@ func (h *HealthMonitor) runHealthChecks(ctx context.Context) {
 	overallHealthy := true
 
 	for _, check := range h.checks {
-		result := h.executeCheck(ctx, check)
+		result := h.executeCheck(ctx, h.target, check)
 		results[check.Name()] = result
 
 		if result.Status != StatusHealthy {
@@ -179,6 +315,15 @@ func (h *HealthMonitor) runHealthChecks(ctx context.Context) {
 		}
 	}
 
+	for _, tc := range h.targetChecks {
+		result := h.executeCheck(ctx, tc.target, tc.check)
+		results[tc.key] = result
+
+		if result.Status != StatusHealthy {
+			overallHealthy = false
+		}
+	}
+
 	overall := StatusHealthy
 	if !overallHealthy {
 		overall = StatusUnhealthy
@@ -189,16 +334,18 @@ func (h *HealthMonitor) runHealthChecks(ctx context.Context) {
 		Checks:    results,
 		Timestamp: time.Now(),
 	}
+	h.publish(h.status)
+	return h.status
 }
 
-// executeCheck executes a single health check
-func (h *HealthMonitor) executeCheck(ctx context.Context, check HealthCheck) CheckResult {
+// executeCheck executes a single health check against the given target
+func (h *HealthMonitor) executeCheck(ctx context.Context, target HealthTarget, check HealthCheck) CheckResult {
 	start := time.Now()
 
 	checkCtx, cancel := context.WithTimeout(ctx, check.Timeout())
 	defer cancel()
 
-	err := check.Check(checkCtx, h.target)
+	err := check.Check(checkCtx, target)
 	duration := time.Since(start)
 
 	result := CheckResult{
@@ -0,0 +1,87 @@
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTarget is a health target with fixed endpoints
+type fakeTarget struct{}
+
+func (fakeTarget) HealthEndpoint() string   { return "http://localhost:8080/health" }
+func (fakeTarget) ConnectionString() string { return "localhost:8080" }
+
+func TestFailingChecks(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks map[string]CheckResult
+		want   string
+	}{
+		{
+			name:   "all healthy",
+			checks: map[string]CheckResult{"http": {Status: StatusHealthy}},
+			want:   "",
+		},
+		{
+			name: "sorted by name",
+			checks: map[string]CheckResult{
+				"tcp":      {Status: StatusUnhealthy, Error: "connection refused"},
+				"http":     {Status: StatusHealthy},
+				"database": {Status: StatusUnhealthy, Error: "timeout"},
+			},
+			want: "database (timeout), tcp (connection refused)",
+		},
+		{
+			name:   "unknown status is failing",
+			checks: map[string]CheckResult{"http": {Status: StatusUnknown}},
+			want:   "http ()",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, failingChecks(HealthStatus{Checks: tt.checks}))
+		})
+	}
+}
+
+func TestSubscribeReceivesLatestStatus(t *testing.T) {
+	h := NewHealthMonitor(fakeTarget{})
+	updates, unsubscribe := h.Subscribe()
+	defer unsubscribe()
+
+	h.mutex.Lock()
+	h.publish(HealthStatus{Overall: StatusUnhealthy})
+	h.publish(HealthStatus{Overall: StatusHealthy})
+	h.mutex.Unlock()
+
+	status := <-updates
+	require.Equal(t, StatusHealthy, status.Overall)
+
+	select {
+	case status := <-updates:
+		t.Fatalf("unexpected stale status %s", status.Overall)
+	default:
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	h := NewHealthMonitor(fakeTarget{})
+	first, unsubscribeFirst := h.Subscribe()
+	second, unsubscribeSecond := h.Subscribe()
+	defer unsubscribeSecond()
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+
+	_, ok := <-first
+	require.False(t, ok, "channel not closed")
+
+	h.mutex.Lock()
+	h.publish(HealthStatus{Overall: StatusHealthy})
+	h.mutex.Unlock()
+
+	status := <-second
+	require.Equal(t, StatusHealthy, status.Overall)
+}
@@ -0,0 +1,133 @@
+package testkit
+
+import (
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// specified is a container that can describe how it is run
+type specified interface {
+	Spec() (container.ContainerSpec, error)
+}
+
+// composeFile is the subset of the compose file format the environment exports
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+	Networks map[string]composeNetwork `yaml:"networks"`
+}
+
+// composeService is a single service of a compose file
+type composeService struct {
+	Image       string                           `yaml:"image,omitempty"`
+	Build       *composeBuild                    `yaml:"build,omitempty"`
+	Command     []string                         `yaml:"command,omitempty"`
+	Environment map[string]string                `yaml:"environment,omitempty"`
+	Ports       []string                         `yaml:"ports,omitempty"`
+	Networks    map[string]composeServiceNetwork `yaml:"networks"`
+	DependsOn   []string                         `yaml:"depends_on,omitempty"`
+}
+
+// composeBuild is the build section of a compose service
+type composeBuild struct {
+	Context    string `yaml:"context"`
+	Dockerfile string `yaml:"dockerfile,omitempty"`
+}
+
+// composeServiceNetwork attaches a compose service to a network
+type composeServiceNetwork struct {
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+// composeNetwork is a network of a compose file
+type composeNetwork struct {
+	Name string `yaml:"name"`
+}
+
+// ToComposeYAML exports the environment as a docker-compose.yml, so that a
+// failing setup can be reproduced with `docker compose up` and debugged
+// interactively. Services are named by their network alias, the application
+// being "app", and publish their container ports on random host ports.
+//
+// The export contains the resolved environment, including passwords and
+// other secrets. Call it after Up to include the dependency URLs Up injects
+// into the application; before Up the application environment only holds
+// the explicitly configured variables.
+func (e *Environment) ToComposeYAML() (string, error) {
+	file := composeFile{
+		Services: make(map[string]composeService),
+		Networks: map[string]composeNetwork{
+			"default": {Name: e.networkName},
+		},
+	}
+
+	aliasCounts := make(map[string]int)
+	dependsOn := make([]string, 0, len(e.app.Dependencies()))
+
+	for _, dep := range e.app.Dependencies() {
+		alias := e.aliases[dep.ID()]
+		if alias == "" {
+			alias = dependencyAlias(dep, aliasCounts)
+		}
+
+		service, err := composeServiceFor(dep, alias)
+		if err != nil {
+			return "", err
+		}
+		file.Services[alias] = service
+		dependsOn = append(dependsOn, alias)
+	}
+
+	appService, err := composeServiceFor(e.app, "app")
+	if err != nil {
+		return "", err
+	}
+	if len(dependsOn) > 0 {
+		appService.DependsOn = dependsOn
+	}
+	file.Services["app"] = appService
+
+	content, err := yaml.Marshal(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode compose file: %w", err)
+	}
+
+	return string(content), nil
+}
+
+// composeServiceFor describes a container as a compose service reachable
+// under alias on the environment network
+func composeServiceFor(c interface{ ID() string }, alias string) (composeService, error) {
+	described, ok := c.(specified)
+	if !ok {
+		return composeService{}, fmt.Errorf("container %s cannot be exported to a compose file", c.ID())
+	}
+
+	spec, err := described.Spec()
+	if err != nil {
+		return composeService{}, fmt.Errorf("failed to describe container %s: %w", c.ID(), err)
+	}
+
+	service := composeService{
+		Image:       spec.Image,
+		Command:     spec.Command,
+		Environment: spec.Environment,
+		Networks: map[string]composeServiceNetwork{
+			"default": {Aliases: []string{alias}},
+		},
+	}
+	if spec.BuildContext != "" {
+		service.Build = &composeBuild{
+			Context:    spec.BuildContext,
+			Dockerfile: spec.Dockerfile,
+		}
+	}
+	for _, port := range spec.Ports {
+		service.Ports = append(service.Ports, strconv.Itoa(port))
+	}
+
+	return service, nil
+}
@@ -0,0 +1,157 @@
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+
+	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
+	"github.com/fintechain/skeleton-testkit/internal/infrastructure/docker"
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// networkMember is a container that can join a user-defined network
+type networkMember interface {
+	JoinNetwork(network string, aliases ...string)
+}
+
+// internallyAddressable is a container that can report its in-network connection string
+type internallyAddressable interface {
+	InternalConnectionString() string
+}
+
+// Environment runs a skeleton application together with its dependencies on a
+// shared user-defined network, like a minimal docker-compose project. Each
+// dependency is reachable from the application by a stable alias, and its
+// in-network connection string is injected into the application environment.
+type Environment struct {
+	app         *container.AppContainer
+	networkName string
+	network     testcontainers.Network
+	aliases     map[string]string
+}
+
+// NewEnvironment creates a new environment for the application container and
+// the dependencies registered on it
+func NewEnvironment(app *container.AppContainer) *Environment {
+	return &Environment{
+		app:         app,
+		networkName: "skeleton-testkit-" + randomString(8),
+		aliases:     make(map[string]string),
+	}
+}
+
+// App returns the application container of the environment
+func (e *Environment) App() *container.AppContainer {
+	return e.app
+}
+
+// NetworkName returns the name of the environment's shared network
+func (e *Environment) NetworkName() string {
+	return e.networkName
+}
+
+// Alias returns the network alias of the dependency with the given container ID,
+// or an empty string if the dependency is not part of the environment
+func (e *Environment) Alias(containerID string) string {
+	return e.aliases[containerID]
+}
+
+// Validate checks that the image of the application and of every dependency
+// is available, without creating the network or starting any container, so
+// that a misconfigured environment fails before anything boots. Every
+// unavailable image is reported.
+func (e *Environment) Validate(ctx context.Context) error {
+	return e.app.Validate(ctx)
+}
+
+// Up creates the shared network, attaches the application and every dependency
+// to it, and starts the environment. Dependencies are aliased by type
+// ("postgres", "redis", with a numeric suffix for duplicates) and their
+// in-network connection strings are injected into the application as
+// <ALIAS>_URL environment variables, e.g. POSTGRES_URL.
+func (e *Environment) Up(ctx context.Context) error {
+	if e.network != nil {
+		return fmt.Errorf("environment is already up")
+	}
+
+	network, err := docker.CreateNetwork(ctx, e.networkName)
+	if err != nil {
+		return err
+	}
+	e.network = network
+
+	env := make(map[string]string)
+	aliasCounts := make(map[string]int)
+
+	for _, dep := range e.app.Dependencies() {
+		member, ok := dep.(networkMember)
+		if !ok {
+			return fmt.Errorf("dependency %s cannot join network %s", dep.ID(), e.networkName)
+		}
+
+		alias := dependencyAlias(dep, aliasCounts)
+		member.JoinNetwork(e.networkName, alias)
+		e.aliases[dep.ID()] = alias
+
+		if addressable, ok := dep.(internallyAddressable); ok {
+			env[aliasEnvKey(alias)] = addressable.InternalConnectionString()
+		}
+	}
+
+	e.app.JoinNetwork(e.networkName, "app")
+	e.app.WithEnvironment(env)
+
+	if err := e.app.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start environment: %w", err)
+	}
+
+	return nil
+}
+
+// Down stops the application and its dependencies and removes the shared network.
+// Cleanup still runs if ctx has already been cancelled.
+func (e *Environment) Down(ctx context.Context) error {
+	if e.network == nil {
+		return nil
+	}
+
+	stopErr := e.app.Stop(ctx)
+
+	cleanupCtx, cancel := docker.CleanupContext(ctx)
+	defer cancel()
+	removeErr := e.network.Remove(cleanupCtx)
+	e.network = nil
+
+	if stopErr != nil {
+		return fmt.Errorf("failed to stop environment: %w", stopErr)
+	}
+	if removeErr != nil {
+		return fmt.Errorf("failed to remove network %s: %w", e.networkName, removeErr)
+	}
+
+	return nil
+}
+
+// dependencyAlias returns the network alias for a dependency, numbering
+// repeated aliases ("postgres", "postgres-2", ...)
+func dependencyAlias(dep domaincontainer.Container, aliasCounts map[string]int) string {
+	alias := container.DependencyType(dep)
+	if alias == "container" {
+		alias = dep.Name()
+	}
+
+	aliasCounts[alias]++
+	if count := aliasCounts[alias]; count > 1 {
+		alias = fmt.Sprintf("%s-%d", alias, count)
+	}
+	return alias
+}
+
+// aliasEnvKey returns the environment variable name carrying the connection
+// string of the dependency with the given alias
+func aliasEnvKey(alias string) string {
+	return strings.ToUpper(strings.ReplaceAll(alias, "-", "_")) + "_URL"
+}
@@ -0,0 +1,33 @@
+package testkit
+
+import (
+	"os"
+	"testing"
+)
+
+// TempDir creates a host directory for data shared with containers, e.g.
+// through AppContainer.WithVolume, and removes it with t.Cleanup when the test
+// completes. Unlike t.TempDir, the directory is writable by any user, so that
+// applications running as a non-root container user can write to it, and a
+// failure to remove files the container created with another owner is logged
+// rather than failing the test.
+func TempDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", "skeleton-testkit-")
+	if err != nil {
+		t.Fatalf("failed to create temp directory: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Logf("failed to remove temp directory %s: %v", dir, err)
+		}
+	})
+
+	// MkdirTemp creates the directory with mode 0700, subject to the umask
+	if err := os.Chmod(dir, 0o777); err != nil {
+		t.Fatalf("failed to make temp directory %s writable: %v", dir, err)
+	}
+
+	return dir
+}
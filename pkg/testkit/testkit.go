@@ -4,8 +4,13 @@
 package testkit
 
 import (
+	"context"
 	"math/rand"
+	"strings"
+	"sync"
+	"testing"
 	"time"
+	"unicode"
 
 	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
 	"github.com/fintechain/skeleton-testkit/internal/infrastructure/docker"
@@ -17,6 +22,50 @@ func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
+// ContainerBackend runs containers on a container runtime. It creates the
+// containers, returned as BackendContainer, and performs every other runtime
+// operation of the testkit, such as removing containers, reading logs,
+// pulling and inspecting images and pruning volumes, so that a runtime like containerd
+// or Colima can be plugged in with SetBackend.
+type ContainerBackend = docker.ContainerBackend
+
+// BackendContainer is a container created by a ContainerBackend, providing
+// its lifecycle, state, port, logs and exec operations.
+// testcontainers.Container implements it.
+type BackendContainer = docker.BackendContainer
+
+// LogOptions selects the container output returned by a ContainerBackend
+type LogOptions = docker.LogOptions
+
+// ImageInfo describes an image known to a ContainerBackend
+type ImageInfo = docker.ImageInfo
+
+// RuntimeInfo describes the container runtime of a ContainerBackend
+type RuntimeInfo = docker.RuntimeInfo
+
+// ManagedContainerInfo describes a container the testkit created in this
+// process: its ID, name, image, kind ("app", "postgres", "redis", ...) and
+// whether it is running
+type ManagedContainerInfo = docker.ManagedContainerInfo
+
+// DockerBackend returns the default backend, which runs containers on Docker
+// or on a runtime auto-detected from DOCKER_HOST
+func DockerBackend() ContainerBackend {
+	return docker.NewDockerBackend()
+}
+
+// PodmanBackend returns a backend running containers on Podman through its
+// Docker-compatible socket
+func PodmanBackend() ContainerBackend {
+	return docker.NewPodmanBackend()
+}
+
+// SetBackend selects the backend used to create all subsequently started
+// containers, allowing the same tests to run on runtimes other than Docker
+func SetBackend(backend ContainerBackend) {
+	docker.SetBackend(backend)
+}
+
 // NewSkeletonApp creates a new container for testing a skeleton-based application
 func NewSkeletonApp(imageName string) *container.AppContainer {
 	config := &domaincontainer.AppConfig{
@@ -27,13 +76,16 @@ func NewSkeletonApp(imageName string) *container.AppContainer {
 
 // NewSkeletonAppWithConfig creates an app container with custom configuration
 func NewSkeletonAppWithConfig(config *domaincontainer.AppConfig) *container.AppContainer {
-	// Convert domain config to infrastructure config
+	// Convert domain config to infrastructure config, sharing the unique ID
+	// suffix with the name to avoid Docker name collisions
+	suffix := randomString(8)
 	containerConfig := &docker.ContainerConfig{
-		ID:          generateContainerID(),
-		Name:        "skeleton-app",
+		ID:          generateContainerID(config.IDPrefix, suffix),
+		Name:        "skeleton-app-" + suffix,
 		Image:       config.ImageName,
 		Environment: config.Environment,
 		Ports:       config.Ports,
+		Volumes:     config.Volumes,
 	}
 
 	// Create testcontainer implementation
@@ -43,6 +95,19 @@ func NewSkeletonAppWithConfig(config *domaincontainer.AppConfig) *container.AppC
 	return container.NewAppContainer(impl)
 }
 
+// NewSkeletonAppForTest creates an app container whose ID is derived from the
+// test name, e.g. "test-basic-skeleton-app-<random>" for TestBasicSkeletonApp,
+// so that containers can be correlated with the tests that created them
+func NewSkeletonAppForTest(t *testing.T, imageName string) *container.AppContainer {
+	t.Helper()
+
+	config := &domaincontainer.AppConfig{
+		IDPrefix:  testNameToIDPrefix(t.Name()),
+		ImageName: imageName,
+	}
+	return NewSkeletonAppWithConfig(config)
+}
+
 // NewPostgresContainer creates a new PostgreSQL container for testing
 func NewPostgresContainer() *container.PostgresContainer {
 	impl := testcontainers.NewPostgresContainer()
@@ -75,10 +140,238 @@ func NewRedisContainerWithConfig(config *RedisConfig) *container.RedisContainer
 	return container.NewRedisContainer(impl)
 }
 
-// generateContainerID generates a unique container ID
-func generateContainerID() string {
-	// Simple implementation - in practice this would be more sophisticated
-	return "container-" + randomString(8)
+// NewRedisSentinelCluster creates a Redis primary with replicas monitored by
+// sentinels. At least one sentinel is run; three by default.
+func NewRedisSentinelCluster(config RedisSentinelConfig) *container.RedisSentinelCluster {
+	impl := testcontainers.NewRedisSentinelCluster(testcontainers.RedisSentinelConfig{
+		Image:      config.Image,
+		MasterName: config.MasterName,
+		Replicas:   config.Replicas,
+		Sentinels:  config.Sentinels,
+	})
+	return container.NewRedisSentinelCluster(impl)
+}
+
+// StartPostgres starts a PostgreSQL container for the test and returns its
+// connection string along with a function removing the container. The function
+// is also registered with t.Cleanup, so calling it is only needed to remove the
+// container early. A failure to start fails the test.
+func StartPostgres(t *testing.T) (string, func()) {
+	t.Helper()
+
+	postgres := NewPostgresContainer()
+	stop := startForTest(t, postgres, postgres.Terminate)
+	return postgres.ConnectionString(), stop
+}
+
+// StartRedis starts a Redis container for the test and returns its connection
+// string along with a function removing the container, which is also
+// registered with t.Cleanup. A failure to start fails the test.
+func StartRedis(t *testing.T) (string, func()) {
+	t.Helper()
+
+	redis := NewRedisContainer()
+	stop := startForTest(t, redis, redis.Terminate)
+	return redis.ConnectionString(), stop
+}
+
+// startForTest starts a container, registering its removal with t.Cleanup, and
+// returns the idempotent removal function
+func startForTest(t *testing.T, c domaincontainer.Container, terminate func(context.Context) error) func() {
+	t.Helper()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			if err := terminate(context.Background()); err != nil {
+				t.Logf("failed to remove container %s: %v", c.Name(), err)
+			}
+		})
+	}
+	t.Cleanup(stop)
+
+	if err := c.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start container %s: %v", c.Name(), err)
+	}
+
+	return stop
+}
+
+// LoadImage loads an image archive produced by `docker save` into the Docker
+// daemon and returns the reference of the loaded image, for use with
+// NewSkeletonApp. This supports environments where images must be side-loaded
+// because pulling from a registry is impossible.
+func LoadImage(ctx context.Context, tarPath string) (string, error) {
+	return docker.LoadImage(ctx, tarPath)
+}
+
+// PipeLogsToT forwards the application container's log output to t.Log, one
+// line at a time, prefixed with the container name. Forwarding stops when the
+// test and its subtests complete, making `go test -v` show the application
+// output inline with test progress.
+func PipeLogsToT(t *testing.T, app *container.AppContainer) {
+	t.Helper()
+
+	name := app.Name()
+	err := app.FollowLogs(func(stream, line string) {
+		t.Logf("[%s] %s", name, line)
+	})
+	if err != nil {
+		t.Fatalf("failed to follow logs for container %s: %v", name, err)
+	}
+
+	t.Cleanup(func() {
+		if err := app.StopFollowingLogs(); err != nil {
+			t.Logf("failed to stop following logs for container %s: %v", name, err)
+		}
+	})
+}
+
+// DumpOnFailure writes a debug dump of the application container, including
+// its annotations and logs, to the test log if the test fails
+func DumpOnFailure(t *testing.T, app *container.AppContainer) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+
+		var dump strings.Builder
+		if err := app.DebugDump(context.Background(), &dump); err != nil {
+			t.Logf("failed to dump container %s: %v", app.Name(), err)
+			return
+		}
+		t.Log(dump.String())
+	})
+}
+
+// SetContainerNamePrefix prepends a prefix, such as a CI pipeline ID, to the
+// name of every container subsequently created by the testkit and records it
+// in the "org.fintechain.skeleton-testkit.prefix" label, so that pipelines
+// sharing a Docker daemon can tell their containers apart and reap only their
+// own. An empty prefix disables prefixing.
+func SetContainerNamePrefix(prefix string) {
+	docker.SetContainerNamePrefix(prefix)
+}
+
+// SetDefaultReadyTimeout sets how long every container type may take to become
+// ready, unless overridden per container with WithReadyTimeout. Raise it once
+// for slow CI environments instead of adjusting per-test budgets. A zero or
+// negative timeout restores the default of 30 seconds.
+func SetDefaultReadyTimeout(timeout time.Duration) {
+	docker.SetDefaultReadyTimeout(timeout)
+}
+
+// SetStateCacheTTL sets how long a container's IsRunning reuses the state it
+// last read from the Docker daemon, 500ms by default. Sub-second staleness is
+// harmless for readiness polling and greatly reduces daemon load when many
+// containers are polled concurrently; Start, Stop and Terminate always
+// invalidate the cached state. Zero or a negative TTL disables caching.
+func SetStateCacheTTL(ttl time.Duration) {
+	docker.SetStateCacheTTL(ttl)
+}
+
+// SetMaxConcurrentStarts limits how many containers the testkit creates or
+// starts at the same time, protecting small CI Docker daemons from bursts of
+// parallel starts. A limit of zero or less, the default, means unlimited.
+func SetMaxConcurrentStarts(n int) {
+	docker.SetMaxConcurrentStarts(n)
+}
+
+// PruneVolumes removes unused volumes labeled as managed by the testkit,
+// reclaiming disk space on long-lived CI agents, and returns the names of the
+// removed volumes. The testkit labels the volumes declared by the images it
+// runs, such as the data directory of PostgreSQL, which are left behind when a
+// container is removed with WithKeepVolumes. Volumes of images built from a
+// Dockerfile are not labeled.
+func PruneVolumes(ctx context.Context) ([]string, error) {
+	return docker.PruneVolumes(ctx)
+}
+
+// ManagedContainers returns the containers the testkit constructed in this
+// process that have not been stopped or terminated, in registration order.
+// Containers not started yet are included, and a stopped container is listed
+// again once restarted. An empty result at the end of a suite shows that every
+// container was stopped.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    code := m.Run()
+//	    for _, c := range testkit.ManagedContainers() {
+//	        fmt.Printf("leaked container %s (%s)\n", c.Name, c.Kind)
+//	    }
+//	    os.Exit(code)
+//	}
+func ManagedContainers() []ManagedContainerInfo {
+	return docker.ManagedContainers()
+}
+
+// InstallSignalCleanup traps SIGINT and SIGTERM in the test process and, when
+// one arrives, force-removes every container the testkit created in this
+// process before exiting, since deferred and t.Cleanup functions do not run
+// on an interrupt. This covers setups where Ryuk, the testcontainers reaper,
+// is disabled. It is opt-in, typically called from TestMain, and idempotent.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//	    testkit.InstallSignalCleanup()
+//	    os.Exit(m.Run())
+//	}
+func InstallSignalCleanup() {
+	docker.InstallSignalCleanup()
+}
+
+// SkipIfNoGPU skips the test if the Docker daemon cannot provide GPUs,
+// allowing GPU-dependent tests to run only on GPU-capable hosts
+func SkipIfNoGPU(t *testing.T) {
+	t.Helper()
+
+	ok, err := docker.HasGPURuntime(context.Background())
+	if err != nil {
+		t.Skipf("skipping GPU test: %v", err)
+	}
+	if !ok {
+		t.Skip("skipping GPU test: docker daemon has no NVIDIA container runtime")
+	}
+}
+
+// generateContainerID generates a container ID from the given prefix and unique suffix
+func generateContainerID(prefix, suffix string) string {
+	if prefix == "" {
+		prefix = "container"
+	}
+	return prefix + "-" + suffix
+}
+
+// testNameToIDPrefix converts a test name such as "TestBasicSkeletonApp/with_db"
+// into a lowercase, dash-separated ID prefix such as "test-basic-skeleton-app-with-db"
+func testNameToIDPrefix(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			// Start a new word at a lower-to-upper transition, or at the last
+			// capital of an acronym followed by a lowercase letter ("HTTPServer")
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteRune('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case r < unicode.MaxASCII && (unicode.IsLower(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	// Collapse separator runs left by punctuation and subtest separators
+	parts := strings.FieldsFunc(b.String(), func(r rune) bool { return r == '-' })
+	return strings.Join(parts, "-")
 }
 
 // randomString generates a random string of the given length
@@ -104,3 +397,11 @@ type RedisConfig struct {
 	Password string `json:"password"`
 	Image    string `json:"image"`
 }
+
+// RedisSentinelConfig holds configuration for Redis Sentinel topologies
+type RedisSentinelConfig struct {
+	Replicas   int    `json:"replicas"`
+	Sentinels  int    `json:"sentinels"`  // 3 if zero
+	MasterName string `json:"masterName"` // "mymaster" if empty
+	Image      string `json:"image"`      // "redis:7" if empty
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/fintechain/skeleton-testkit/pkg/container"
@@ -59,9 +60,14 @@ func (c *ComponentVerifier) VerifySkeletonComponentInitialized(ctx context.Conte
 		return fmt.Errorf("unable to get application connection string")
 	}
 
-	statusURL := fmt.Sprintf("%s/api/components/%s/status", baseURL, componentID)
+	componentsPath, err := c.componentsPath()
+	if err != nil {
+		return err
+	}
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	statusURL := fmt.Sprintf("%s%s/%s/status", baseURL, componentsPath, componentID)
+
+	client := newHTTPClient(ctx)
 	req, err := http.NewRequestWithContext(ctx, "GET", statusURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
@@ -80,6 +86,70 @@ func (c *ComponentVerifier) VerifySkeletonComponentInitialized(ctx context.Conte
 	return nil
 }
 
+// ComponentStatus is the body of a component status endpoint, e.g.
+//
+//	{"state": "running", "healthy": true, "message": ""}
+//
+// State describes the component lifecycle (e.g. "initializing", "running",
+// "stopped"); Healthy reports whether the component considers itself healthy;
+// Message optionally explains an unhealthy status.
+type ComponentStatus struct {
+	State   string `json:"state"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+// VerifyComponentHealthy verifies that a skeleton component reports itself
+// healthy in its status body, rather than only that its status endpoint responds.
+// The body must follow the ComponentStatus schema.
+func (c *ComponentVerifier) VerifyComponentHealthy(ctx context.Context, componentID string) error {
+	if !c.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	baseURL := c.app.ConnectionString()
+	if baseURL == "" {
+		return fmt.Errorf("unable to get application connection string")
+	}
+
+	componentsPath, err := c.componentsPath()
+	if err != nil {
+		return err
+	}
+
+	statusURL := fmt.Sprintf("%s%s/%s/status", baseURL, componentsPath, componentID)
+
+	client := newHTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", statusURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach component status endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("component %s status endpoint returned status %d", componentID, resp.StatusCode)
+	}
+
+	var status ComponentStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return fmt.Errorf("failed to decode component %s status: %w", componentID, err)
+	}
+
+	if !status.Healthy {
+		if status.Message != "" {
+			return fmt.Errorf("component %s is not healthy (state %q): %s", componentID, status.State, status.Message)
+		}
+		return fmt.Errorf("component %s is not healthy (state %q)", componentID, status.State)
+	}
+
+	return nil
+}
+
 // VerifySkeletonComponentDisposed verifies that a skeleton component is properly disposed
 func (c *ComponentVerifier) VerifySkeletonComponentDisposed(ctx context.Context, componentID string) error {
 	if !c.app.IsRunning() {
@@ -100,53 +170,297 @@ func (c *ComponentVerifier) VerifySkeletonComponentDisposed(ctx context.Context,
 	return nil
 }
 
+// VerifyComponentNotRegistered verifies that a skeleton component is absent from
+// the registry, for example because a feature flag or profile excluded it.
+// Unlike VerifySkeletonComponentDisposed it makes no claim about whether the
+// component ever existed.
+func (c *ComponentVerifier) VerifyComponentNotRegistered(ctx context.Context, componentID string) error {
+	if !c.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	components, err := c.getRegisteredComponents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get registered components: %w", err)
+	}
+
+	for _, comp := range components {
+		if comp == componentID {
+			return fmt.Errorf("component %s is registered but should not be (registered components: %v)", componentID, components)
+		}
+	}
+
+	return nil
+}
+
 // VerifySkeletonComponentMetadata verifies component metadata matches expected values
 func (c *ComponentVerifier) VerifySkeletonComponentMetadata(ctx context.Context, componentID string, expected map[string]interface{}) error {
 	if !c.app.IsRunning() {
 		return fmt.Errorf("skeleton application is not running")
 	}
 
-	// Get component metadata
+	metadata, err := c.getComponentMetadata(ctx, componentID)
+	if err != nil {
+		return err
+	}
+
+	// Verify expected metadata
+	for key, expectedValue := range expected {
+		actualValue, exists := metadata[key]
+		if !exists {
+			return fmt.Errorf("component %s missing metadata key %s", componentID, key)
+		}
+		if actualValue != expectedValue {
+			return fmt.Errorf("component %s metadata key %s: expected %v, got %v", componentID, key, expectedValue, actualValue)
+		}
+	}
+
+	return nil
+}
+
+// componentMetricLabel is the label identifying the component a metric sample belongs to
+const componentMetricLabel = "component"
+
+// VerifyComponentMetric verifies that the named metric of a single component
+// satisfies the predicate. Only samples whose "component" label matches
+// componentID are considered; their values are summed across the remaining
+// label combinations before the predicate is evaluated.
+func (c *ComponentVerifier) VerifyComponentMetric(ctx context.Context, componentID, metricName string, predicate func(float64) bool) error {
+	if !c.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	samples, err := NewMetricsVerifier(c.app).getMetrics(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	found := false
+	total := 0.0
+	for _, sample := range samples {
+		if sample.Name == metricName && sample.Labels[componentMetricLabel] == componentID {
+			found = true
+			total += sample.Value
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("metric %s is not exposed for component %s", metricName, componentID)
+	}
+
+	if !predicate(total) {
+		return fmt.Errorf("metric %s of component %s has value %v, which does not satisfy the predicate", metricName, componentID, total)
+	}
+
+	return nil
+}
+
+// ListComponents returns the IDs of the registered skeleton components
+func (c *ComponentVerifier) ListComponents(ctx context.Context) ([]string, error) {
+	if !c.app.IsRunning() {
+		return nil, fmt.Errorf("skeleton application is not running")
+	}
+
+	return c.getRegisteredComponents(ctx)
+}
+
+// WaitForComponentsStable waits until the list of registered components stops
+// changing for at least quietPeriod and returns the settled list. This avoids
+// racing against plugins that are still being loaded dynamically.
+func (c *ComponentVerifier) WaitForComponentsStable(ctx context.Context, quietPeriod, timeout time.Duration) ([]string, error) {
+	if !c.app.IsRunning() {
+		return nil, fmt.Errorf("skeleton application is not running")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pollInterval := 500 * time.Millisecond
+	if quietPeriod > 0 && quietPeriod < pollInterval {
+		pollInterval = quietPeriod
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var (
+		last       []string
+		observed   bool
+		lastChange time.Time
+		lastErr    error
+	)
+
+	for {
+		components, err := c.getRegisteredComponents(timeoutCtx)
+		if err != nil {
+			lastErr = err
+		} else {
+			lastErr = nil
+			if !observed || !sameComponentSet(last, components) {
+				last = components
+				observed = true
+				lastChange = time.Now()
+			} else if time.Since(lastChange) >= quietPeriod {
+				return last, nil
+			}
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			if lastErr != nil {
+				return last, fmt.Errorf("timeout waiting for components to stabilize: %w", lastErr)
+			}
+			return last, fmt.Errorf("timeout waiting for components to stabilize: last observed %d components", len(last))
+		case <-ticker.C:
+		}
+	}
+}
+
+// VerifyComponentCountIncreases verifies that the number of registered
+// components grows by at least by after running trigger, e.g. a call to an
+// admin API installing a plugin at runtime. The count is recorded before the
+// trigger runs and polled until it has grown enough or timeout expires.
+func (c *ComponentVerifier) VerifyComponentCountIncreases(ctx context.Context, trigger func() error, by int, timeout time.Duration) error {
+	if !c.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	before, err := c.getRegisteredComponents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get registered components: %w", err)
+	}
+
+	if err := trigger(); err != nil {
+		return fmt.Errorf("trigger failed: %w", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	expected := len(before) + by
+	current := len(before)
+	var lastErr error
+
+	for {
+		components, err := c.getRegisteredComponents(timeoutCtx)
+		if err != nil {
+			lastErr = err
+		} else {
+			lastErr = nil
+			current = len(components)
+			if current >= expected {
+				return nil
+			}
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("timeout waiting for component count to reach %d: %w", expected, lastErr)
+			}
+			return fmt.Errorf("component count grew from %d to %d, expected an increase of %d", len(before), current, by)
+		case <-ticker.C:
+		}
+	}
+}
+
+// diffComponents returns the components of expected missing from actual and
+// the components of actual not present in expected
+func diffComponents(expected, actual []string) (missing, unexpected []string) {
+	expectedSet := make(map[string]bool, len(expected))
+	for _, comp := range expected {
+		expectedSet[comp] = true
+	}
+	actualSet := make(map[string]bool, len(actual))
+	for _, comp := range actual {
+		actualSet[comp] = true
+	}
+
+	missing = make([]string, 0)
+	for _, comp := range expected {
+		if !actualSet[comp] {
+			missing = append(missing, comp)
+		}
+	}
+	unexpected = make([]string, 0)
+	for _, comp := range actual {
+		if !expectedSet[comp] {
+			unexpected = append(unexpected, comp)
+		}
+	}
+
+	return missing, unexpected
+}
+
+// sameComponentSet reports whether two component lists contain the same
+// components, ignoring order
+func sameComponentSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getComponentMetadata retrieves the metadata of a component
+func (c *ComponentVerifier) getComponentMetadata(ctx context.Context, componentID string) (map[string]interface{}, error) {
 	baseURL := c.app.ConnectionString()
 	if baseURL == "" {
-		return fmt.Errorf("unable to get application connection string")
+		return nil, fmt.Errorf("unable to get application connection string")
 	}
 
-	metadataURL := fmt.Sprintf("%s/api/components/%s/metadata", baseURL, componentID)
+	componentsPath, err := c.componentsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	metadataURL := fmt.Sprintf("%s%s/%s/metadata", baseURL, componentsPath, componentID)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := newHTTPClient(ctx)
 	req, err := http.NewRequestWithContext(ctx, "GET", metadataURL, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to reach component metadata endpoint: %w", err)
+		return nil, fmt.Errorf("failed to reach component metadata endpoint: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("component %s metadata endpoint returned status %d", componentID, resp.StatusCode)
+		return nil, fmt.Errorf("component %s metadata endpoint returned status %d", componentID, resp.StatusCode)
 	}
 
 	var metadata map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
-		return fmt.Errorf("failed to decode component metadata: %w", err)
+		return nil, fmt.Errorf("failed to decode component metadata: %w", err)
 	}
 
-	// Verify expected metadata
-	for key, expectedValue := range expected {
-		actualValue, exists := metadata[key]
-		if !exists {
-			return fmt.Errorf("component %s missing metadata key %s", componentID, key)
-		}
-		if actualValue != expectedValue {
-			return fmt.Errorf("component %s metadata key %s: expected %v, got %v", componentID, key, expectedValue, actualValue)
-		}
-	}
+	return metadata, nil
+}
 
-	return nil
+// componentsPath returns the configured components endpoint path
+func (c *ComponentVerifier) componentsPath() (string, error) {
+	componentsPath := c.app.SkeletonContract().ComponentsPath
+	if componentsPath == "" {
+		return "", fmt.Errorf("components endpoint not configured")
+	}
+	return componentsPath, nil
 }
 
 // getRegisteredComponents retrieves the list of registered components
@@ -156,9 +470,14 @@ func (c *ComponentVerifier) getRegisteredComponents(ctx context.Context) ([]stri
 		return nil, fmt.Errorf("unable to get application connection string")
 	}
 
-	componentsURL := baseURL + "/api/components"
+	componentsPath, err := c.componentsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	componentsURL := baseURL + componentsPath
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := newHTTPClient(ctx)
 	req, err := http.NewRequestWithContext(ctx, "GET", componentsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
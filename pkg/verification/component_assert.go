@@ -0,0 +1,102 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// ComponentExpectation describes the expected set of registered components.
+// Only the fields that are set are checked.
+type ComponentExpectation struct {
+	// Exactly lists every component that must be registered, ignoring order;
+	// a non-nil empty list expects no components
+	Exactly []string
+	// AtLeast lists components that must be registered among others
+	AtLeast []string
+	// None lists components that must not be registered
+	None []string
+	// OnlyTypes lists the allowed component types, read from the "type" key
+	// of each component's metadata
+	OnlyTypes []string
+}
+
+// AssertComponents verifies the registered components of the skeleton application
+// against the expectation. The component list is fetched once, and every
+// violation is reported with the missing and unexpected components.
+func AssertComponents(ctx context.Context, app *container.AppContainer, expectation ComponentExpectation) error {
+	verifier := NewComponentVerifier(app)
+
+	components, err := verifier.ListComponents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list components: %w", err)
+	}
+
+	failures := make([]string, 0)
+
+	if expectation.Exactly != nil {
+		missing, unexpected := diffComponents(expectation.Exactly, components)
+		if len(missing) > 0 || len(unexpected) > 0 {
+			failures = append(failures, fmt.Sprintf("expected exactly %v (missing: %v, unexpected: %v)",
+				expectation.Exactly, missing, unexpected))
+		}
+	}
+
+	if len(expectation.AtLeast) > 0 {
+		missing, _ := diffComponents(expectation.AtLeast, components)
+		if len(missing) > 0 {
+			failures = append(failures, fmt.Sprintf("expected at least %v (missing: %v)", expectation.AtLeast, missing))
+		}
+	}
+
+	if len(expectation.None) > 0 {
+		registered := make(map[string]bool, len(components))
+		for _, comp := range components {
+			registered[comp] = true
+		}
+
+		unexpected := make([]string, 0)
+		for _, comp := range expectation.None {
+			if registered[comp] {
+				unexpected = append(unexpected, comp)
+			}
+		}
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+			failures = append(failures, fmt.Sprintf("expected none of %v (unexpected: %v)", expectation.None, unexpected))
+		}
+	}
+
+	if len(expectation.OnlyTypes) > 0 {
+		allowed := make(map[string]bool, len(expectation.OnlyTypes))
+		for _, componentType := range expectation.OnlyTypes {
+			allowed[componentType] = true
+		}
+
+		unexpected := make([]string, 0)
+		for _, comp := range components {
+			metadata, err := verifier.getComponentMetadata(ctx, comp)
+			if err != nil {
+				return fmt.Errorf("failed to get component %s type: %w", comp, err)
+			}
+
+			componentType := fmt.Sprint(metadata["type"])
+			if !allowed[componentType] {
+				unexpected = append(unexpected, fmt.Sprintf("%s (%s)", comp, componentType))
+			}
+		}
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+			failures = append(failures, fmt.Sprintf("expected only types %v (unexpected: %v)", expectation.OnlyTypes, unexpected))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("registered components %v do not match: %s", components, strings.Join(failures, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package verification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffComponents(t *testing.T) {
+	tests := []struct {
+		name           string
+		expected       []string
+		actual         []string
+		wantMissing    []string
+		wantUnexpected []string
+	}{
+		{
+			name:           "same components",
+			expected:       []string{"db", "cache"},
+			actual:         []string{"cache", "db"},
+			wantMissing:    []string{},
+			wantUnexpected: []string{},
+		},
+		{
+			name:           "missing components in expected order",
+			expected:       []string{"db", "cache", "queue"},
+			actual:         []string{"cache"},
+			wantMissing:    []string{"db", "queue"},
+			wantUnexpected: []string{},
+		},
+		{
+			name:           "unexpected components in actual order",
+			expected:       []string{"db"},
+			actual:         []string{"tracer", "db", "auth"},
+			wantMissing:    []string{},
+			wantUnexpected: []string{"tracer", "auth"},
+		},
+		{
+			name:           "missing and unexpected",
+			expected:       []string{"db", "cache"},
+			actual:         []string{"db", "queue"},
+			wantMissing:    []string{"cache"},
+			wantUnexpected: []string{"queue"},
+		},
+		{
+			name:           "nothing registered",
+			expected:       []string{"db"},
+			actual:         nil,
+			wantMissing:    []string{"db"},
+			wantUnexpected: []string{},
+		},
+		{
+			name:           "nothing expected",
+			expected:       nil,
+			actual:         []string{"db"},
+			wantMissing:    []string{},
+			wantUnexpected: []string{"db"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missing, unexpected := diffComponents(tt.expected, tt.actual)
+			require.Equal(t, tt.wantMissing, missing)
+			require.Equal(t, tt.wantUnexpected, unexpected)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package verification
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// DefaultErrorLevelPattern matches log lines reported at ERROR or FATAL level
+const DefaultErrorLevelPattern = `(?i)\b(error|fatal)\b`
+
+// VerifyNoErrorLogs verifies that the skeleton application has not logged any
+// line matching levelPattern, catching latent problems that don't surface as
+// health failures. An empty levelPattern uses DefaultErrorLevelPattern. Lines
+// containing any of the allowList substrings are treated as known-benign and
+// ignored. Every offending line is included in the returned error.
+func VerifyNoErrorLogs(ctx context.Context, app *container.AppContainer, levelPattern string, allowList ...string) error {
+	if levelPattern == "" {
+		levelPattern = DefaultErrorLevelPattern
+	}
+
+	pattern, err := regexp.Compile(levelPattern)
+	if err != nil {
+		return fmt.Errorf("invalid log level pattern %q: %w", levelPattern, err)
+	}
+
+	logs, err := app.Logs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get application logs: %w", err)
+	}
+
+	offending := make([]string, 0)
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if pattern.MatchString(line) && !isAllowedLogLine(line, allowList) {
+			offending = append(offending, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read application logs: %w", err)
+	}
+
+	if len(offending) > 0 {
+		return fmt.Errorf("application logged %d error lines:\n%s", len(offending), strings.Join(offending, "\n"))
+	}
+
+	return nil
+}
+
+// isAllowedLogLine reports whether a log line contains any of the allowed substrings
+func isAllowedLogLine(line string, allowList []string) bool {
+	for _, allowed := range allowList {
+		if strings.Contains(line, allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package verification
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// MetricsVerifier verifies the metrics exposed by a skeleton application
+// in the Prometheus text exposition format
+type MetricsVerifier struct {
+	app *container.AppContainer
+}
+
+// NewMetricsVerifier creates a new MetricsVerifier for the given application container
+func NewMetricsVerifier(app *container.AppContainer) *MetricsVerifier {
+	return &MetricsVerifier{
+		app: app,
+	}
+}
+
+// metricSample is a single sample of the Prometheus text exposition format
+type metricSample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// VerifyMetricExists verifies that the metrics endpoint exposes at least one
+// sample of the named metric
+func (m *MetricsVerifier) VerifyMetricExists(ctx context.Context, name string) error {
+	if !m.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	samples, err := m.getMetrics(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	for _, sample := range samples {
+		if sample.Name == name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("metric %s is not exposed", name)
+}
+
+// VerifyMetricLabels verifies that every sample of the named metric carries
+// the required label keys, catching instrumentation regressions that drop a label
+func (m *MetricsVerifier) VerifyMetricLabels(ctx context.Context, name string, requiredLabels ...string) error {
+	if !m.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	samples, err := m.getMetrics(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	found := false
+	violations := make([]string, 0)
+	for _, sample := range samples {
+		if sample.Name != name {
+			continue
+		}
+		found = true
+
+		missing := make([]string, 0)
+		for _, label := range requiredLabels {
+			if _, ok := sample.Labels[label]; !ok {
+				missing = append(missing, label)
+			}
+		}
+		if len(missing) > 0 {
+			violations = append(violations, fmt.Sprintf("%s%s is missing %v", name, formatMetricLabels(sample.Labels), missing))
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("metric %s is not exposed", name)
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("metric %s samples are missing required labels: %s", name, strings.Join(violations, "; "))
+	}
+
+	return nil
+}
+
+// MetricValue returns the value of the named metric, summed across all of its
+// label combinations
+func (m *MetricsVerifier) MetricValue(ctx context.Context, name string) (float64, error) {
+	if !m.app.IsRunning() {
+		return 0, fmt.Errorf("skeleton application is not running")
+	}
+
+	samples, err := m.getMetrics(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	found := false
+	total := 0.0
+	for _, sample := range samples {
+		if sample.Name == name {
+			found = true
+			total += sample.Value
+		}
+	}
+
+	if !found {
+		return 0, fmt.Errorf("metric %s is not exposed", name)
+	}
+
+	return total, nil
+}
+
+// formatMetricLabels formats a label set as in the exposition format, sorted by key
+func formatMetricLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%q", key, labels[key])
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// getMetrics retrieves and parses the samples exposed by the metrics endpoint
+func (m *MetricsVerifier) getMetrics(ctx context.Context) ([]metricSample, error) {
+	baseURL := m.app.ConnectionString()
+	if baseURL == "" {
+		return nil, fmt.Errorf("unable to get application connection string")
+	}
+
+	metricsEndpoint := m.app.MetricsEndpoint()
+	if metricsEndpoint == "" {
+		return nil, fmt.Errorf("metrics endpoint not configured")
+	}
+
+	metricsURL := baseURL + metricsEndpoint
+
+	client := newHTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", metricsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach metrics endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics endpoint returned status %d", resp.StatusCode)
+	}
+
+	return parseMetrics(resp.Body)
+}
+
+// parseMetrics parses samples from the Prometheus text exposition format,
+// skipping comments and blank lines
+func parseMetrics(r io.Reader) ([]metricSample, error) {
+	samples := make([]metricSample, 0)
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sample, err := parseMetricLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse metric line %q: %w", line, err)
+		}
+		samples = append(samples, sample)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %w", err)
+	}
+
+	return samples, nil
+}
+
+// parseMetricLine parses a single sample line of the form
+// name{label="value",...} value [timestamp]
+func parseMetricLine(line string) (metricSample, error) {
+	sample := metricSample{Labels: make(map[string]string)}
+
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd <= 0 {
+		return sample, fmt.Errorf("missing metric value")
+	}
+	sample.Name = line[:nameEnd]
+	rest := line[nameEnd:]
+
+	if strings.HasPrefix(rest, "{") {
+		labels, remainder, err := parseMetricLabels(rest[1:])
+		if err != nil {
+			return sample, err
+		}
+		sample.Labels = labels
+		rest = remainder
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return sample, fmt.Errorf("missing metric value")
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return sample, fmt.Errorf("invalid metric value %q: %w", fields[0], err)
+	}
+	sample.Value = value
+
+	return sample, nil
+}
+
+// parseMetricLabels parses a label set up to and including the closing brace,
+// returning the labels and the remainder of the line
+func parseMetricLabels(s string) (map[string]string, string, error) {
+	labels := make(map[string]string)
+	i := 0
+
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+			i++
+		}
+		if i >= len(s) {
+			return nil, "", fmt.Errorf("unterminated label set")
+		}
+		if s[i] == '}' {
+			return labels, s[i+1:], nil
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		if eq < 0 {
+			return nil, "", fmt.Errorf("malformed label set")
+		}
+		key := strings.TrimSpace(s[i : i+eq])
+		i += eq + 1
+
+		if i >= len(s) || s[i] != '"' {
+			return nil, "", fmt.Errorf("label %s value is not quoted", key)
+		}
+		i++
+
+		var value strings.Builder
+		for {
+			if i >= len(s) {
+				return nil, "", fmt.Errorf("unterminated value for label %s", key)
+			}
+			ch := s[i]
+			if ch == '"' {
+				i++
+				break
+			}
+			if ch == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i])
+				}
+			} else {
+				value.WriteByte(ch)
+			}
+			i++
+		}
+		labels[key] = value.String()
+	}
+}
@@ -0,0 +1,148 @@
+package verification
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetricLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    metricSample
+		wantErr string
+	}{
+		{
+			name: "no labels",
+			line: "process_open_fds 12",
+			want: metricSample{Name: "process_open_fds", Labels: map[string]string{}, Value: 12},
+		},
+		{
+			name: "labels",
+			line: `http_requests_total{method="GET",code="200"} 1027`,
+			want: metricSample{
+				Name:   "http_requests_total",
+				Labels: map[string]string{"method": "GET", "code": "200"},
+				Value:  1027,
+			},
+		},
+		{
+			name: "empty label set",
+			line: "up{} 1",
+			want: metricSample{Name: "up", Labels: map[string]string{}, Value: 1},
+		},
+		{
+			name: "trailing comma and spaces",
+			line: `up{ job="app", } 1`,
+			want: metricSample{Name: "up", Labels: map[string]string{"job": "app"}, Value: 1},
+		},
+		{
+			name: "escaped label values",
+			line: `errors_total{msg="say \"hi\"",path="C:\\tmp",text="a\nb"} 3`,
+			want: metricSample{
+				Name:   "errors_total",
+				Labels: map[string]string{"msg": `say "hi"`, "path": `C:\tmp`, "text": "a\nb"},
+				Value:  3,
+			},
+		},
+		{
+			name: "braces and commas in label values",
+			line: `rpc_calls{method="Get{id}",args="a,b"} 2`,
+			want: metricSample{
+				Name:   "rpc_calls",
+				Labels: map[string]string{"method": "Get{id}", "args": "a,b"},
+				Value:  2,
+			},
+		},
+		{
+			name: "timestamp ignored",
+			line: `http_requests_total{method="POST"} 3 1395066363000`,
+			want: metricSample{Name: "http_requests_total", Labels: map[string]string{"method": "POST"}, Value: 3},
+		},
+		{
+			name: "float and exponent values",
+			line: "request_duration_seconds_sum 1.5e-3",
+			want: metricSample{Name: "request_duration_seconds_sum", Labels: map[string]string{}, Value: 0.0015},
+		},
+		{
+			name:    "missing value",
+			line:    "process_open_fds",
+			wantErr: "missing metric value",
+		},
+		{
+			name:    "missing value after labels",
+			line:    `up{job="app"}`,
+			wantErr: "missing metric value",
+		},
+		{
+			name:    "invalid value",
+			line:    "up one",
+			wantErr: `invalid metric value "one"`,
+		},
+		{
+			name:    "missing closing brace",
+			line:    `up{job="app"`,
+			wantErr: "unterminated label set",
+		},
+		{
+			name:    "missing closing brace before value",
+			line:    `up{job="app" 1`,
+			wantErr: "malformed label set",
+		},
+		{
+			name:    "unterminated label value",
+			line:    `up{job="app} 1`,
+			wantErr: "unterminated value for label job",
+		},
+		{
+			name:    "unquoted label value",
+			line:    `up{job=app} 1`,
+			wantErr: "label job value is not quoted",
+		},
+		{
+			name:    "label without value",
+			line:    `up{job} 1`,
+			wantErr: "malformed label set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample, err := parseMetricLine(tt.line)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, sample)
+		})
+	}
+}
+
+func TestParseMetricLabelsRemainder(t *testing.T) {
+	labels, remainder, err := parseMetricLabels(`a="1",b="2"} 42 1395066363000`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, labels)
+	require.Equal(t, " 42 1395066363000", remainder)
+}
+
+func TestParseMetrics(t *testing.T) {
+	body := `# HELP up Whether the target is up
+# TYPE up gauge
+up 1
+
+http_requests_total{code="200"} 7
+`
+
+	samples, err := parseMetrics(strings.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, []metricSample{
+		{Name: "up", Labels: map[string]string{}, Value: 1},
+		{Name: "http_requests_total", Labels: map[string]string{"code": "200"}, Value: 7},
+	}, samples)
+
+	_, err = parseMetrics(strings.NewReader("up 1\nbroken{\n"))
+	require.ErrorContains(t, err, `failed to parse metric line "broken{"`)
+}
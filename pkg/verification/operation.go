@@ -0,0 +1,190 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// OperationInfo describes a skeleton operation exposed by the application
+type OperationInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// OperationClient lists and invokes the operations of a skeleton application
+// through its operations endpoint
+type OperationClient struct {
+	app *container.AppContainer
+}
+
+// NewOperationClient creates a new OperationClient for the given application container
+func NewOperationClient(app *container.AppContainer) *OperationClient {
+	return &OperationClient{
+		app: app,
+	}
+}
+
+// List returns the operations exposed by the application. Operations listed
+// by ID only are returned with just their ID set.
+func (c *OperationClient) List(ctx context.Context) ([]OperationInfo, error) {
+	operationsURL, err := c.operationsURL()
+	if err != nil {
+		return nil, err
+	}
+
+	client := newHTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", operationsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach operations endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("operations endpoint returned status %d", resp.StatusCode)
+	}
+
+	var entries []json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to decode operations list: %w", err)
+	}
+
+	operations := make([]OperationInfo, 0, len(entries))
+	for _, entry := range entries {
+		var info OperationInfo
+		if err := json.Unmarshal(entry, &info.ID); err != nil {
+			if err := json.Unmarshal(entry, &info); err != nil {
+				return nil, fmt.Errorf("failed to decode operation entry: %w", err)
+			}
+		}
+		operations = append(operations, info)
+	}
+
+	return operations, nil
+}
+
+// Execute invokes a skeleton operation, POSTing input as JSON and decoding the
+// response into out. A nil out discards the response; an empty response body
+// leaves out unchanged.
+func (c *OperationClient) Execute(ctx context.Context, operationID string, input any, out any) error {
+	operationsURL, err := c.operationsURL()
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to encode operation %s input: %w", operationID, err)
+	}
+
+	operationURL := fmt.Sprintf("%s/%s", operationsURL, url.PathEscape(operationID))
+
+	client := newHTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "POST", operationURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach operation %s endpoint: %w", operationID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("operation %s returned status %d", operationID, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to decode operation %s response: %w", operationID, err)
+	}
+
+	return nil
+}
+
+// operationsURL returns the URL of the application's operations endpoint
+func (c *OperationClient) operationsURL() (string, error) {
+	if !c.app.IsRunning() {
+		return "", fmt.Errorf("skeleton application is not running")
+	}
+
+	baseURL := c.app.ConnectionString()
+	if baseURL == "" {
+		return "", fmt.Errorf("unable to get application connection string")
+	}
+
+	operationsPath := c.app.SkeletonContract().OperationsPath
+	if operationsPath == "" {
+		return "", fmt.Errorf("operations endpoint not configured")
+	}
+
+	return baseURL + operationsPath, nil
+}
+
+// OperationVerifier verifies skeleton operation behavior
+type OperationVerifier struct {
+	client  *OperationClient
+	metrics *MetricsVerifier
+}
+
+// NewOperationVerifier creates a new OperationVerifier for the given application container
+func NewOperationVerifier(app *container.AppContainer) *OperationVerifier {
+	return &OperationVerifier{
+		client:  NewOperationClient(app),
+		metrics: NewMetricsVerifier(app),
+	}
+}
+
+// VerifyOperation executes a skeleton operation and verifies that it succeeds,
+// returning the decoded response body
+func (o *OperationVerifier) VerifyOperation(ctx context.Context, operationID string, input map[string]interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	if err := o.client.Execute(ctx, operationID, input, &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// VerifyOperationAndMetric executes a skeleton operation and verifies both that
+// it succeeds and that the named metric increased by expectedIncrease as a result.
+// The metric is summed across all of its label combinations.
+func (o *OperationVerifier) VerifyOperationAndMetric(ctx context.Context, operationID string, input map[string]interface{}, metricName string, expectedIncrease float64) error {
+	before, err := o.metrics.MetricValue(ctx, metricName)
+	if err != nil {
+		return fmt.Errorf("failed to capture metric %s before operation %s: %w", metricName, operationID, err)
+	}
+
+	if _, err := o.VerifyOperation(ctx, operationID, input); err != nil {
+		return err
+	}
+
+	after, err := o.metrics.MetricValue(ctx, metricName)
+	if err != nil {
+		return fmt.Errorf("failed to capture metric %s after operation %s: %w", metricName, operationID, err)
+	}
+
+	if increase := after - before; increase != expectedIncrease {
+		return fmt.Errorf("operation %s: expected metric %s to increase by %v, got %v (before %v, after %v)",
+			operationID, metricName, expectedIncrease, increase, before, after)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fintechain/skeleton-testkit/pkg/container"
+)
+
+// VerifyStartupWithin starts the skeleton application and verifies that the
+// start, including its dependencies and readiness, took at most limit. The
+// measured duration is returned even when the verification fails.
+func VerifyStartupWithin(ctx context.Context, app *container.AppContainer, limit time.Duration) (time.Duration, error) {
+	began := time.Now()
+	err := app.Start(ctx)
+	elapsed := time.Since(began)
+	if err != nil {
+		return elapsed, fmt.Errorf("skeleton application failed to start: %w", err)
+	}
+
+	if elapsed > limit {
+		return elapsed, fmt.Errorf("skeleton application started in %v, expected at most %v", elapsed, limit)
+	}
+
+	return elapsed, nil
+}
+
+// VerifyPullWithin verifies that creating the container during the last start
+// of the skeleton application, including pulling or building its image, took
+// at most limit. It returns the measured duration.
+func VerifyPullWithin(app *container.AppContainer, limit time.Duration) (time.Duration, error) {
+	return verifyPhaseWithin("pull", app.StartupMetrics().Pull, limit)
+}
+
+// VerifyReadinessWithin verifies that the skeleton application became ready
+// within limit of its container being started during the last start. It
+// returns the measured duration.
+func VerifyReadinessWithin(app *container.AppContainer, limit time.Duration) (time.Duration, error) {
+	return verifyPhaseWithin("readiness", app.StartupMetrics().Readiness, limit)
+}
+
+// verifyPhaseWithin verifies that the named startup phase took at most limit
+func verifyPhaseWithin(phase string, elapsed, limit time.Duration) (time.Duration, error) {
+	if elapsed > limit {
+		return elapsed, fmt.Errorf("startup %s phase took %v, expected at most %v", phase, elapsed, limit)
+	}
+	return elapsed, nil
+}
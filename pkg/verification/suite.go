@@ -0,0 +1,113 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Verification is a named check run as part of a suite by RunAll
+type Verification struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Result is the outcome of a single verification of a suite
+type Result struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// Passed reports whether the verification succeeded
+func (r Result) Passed() bool {
+	return r.Err == nil
+}
+
+// Report lists the results of a suite of verifications, in the order they ran
+type Report struct {
+	Results []Result
+}
+
+// Passed reports whether every verification of the suite succeeded
+func (r Report) Passed() bool {
+	return len(r.Failures()) == 0
+}
+
+// Failures returns the results of the verifications that failed
+func (r Report) Failures() []Result {
+	failures := make([]Result, 0)
+	for _, result := range r.Results {
+		if !result.Passed() {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Err returns an error joining every failure of the suite, or nil if all
+// verifications passed
+func (r Report) Err() error {
+	failures := r.Failures()
+	if len(failures) == 0 {
+		return nil
+	}
+
+	errs := make([]error, len(failures))
+	for i, failure := range failures {
+		errs[i] = fmt.Errorf("%s: %w", failure.Name, failure.Err)
+	}
+	return errors.Join(errs...)
+}
+
+// String formats the report with one PASS or FAIL line per verification
+// followed by a summary line
+func (r Report) String() string {
+	var b strings.Builder
+	for _, result := range r.Results {
+		if result.Passed() {
+			fmt.Fprintf(&b, "PASS %s (%s)\n", result.Name, result.Duration.Round(time.Millisecond))
+		} else {
+			fmt.Fprintf(&b, "FAIL %s (%s): %v\n", result.Name, result.Duration.Round(time.Millisecond), result.Err)
+		}
+	}
+	fmt.Fprintf(&b, "%d passed, %d failed", len(r.Results)-len(r.Failures()), len(r.Failures()))
+	return b.String()
+}
+
+// RunAll runs every verification in order and reports all results instead of
+// stopping at the first failure. A verification that panics is reported as
+// failed and does not stop the suite. Verifications still run after ctx is
+// cancelled, each reporting the cancellation as it sees fit.
+func RunAll(ctx context.Context, verifications []Verification) Report {
+	report := Report{Results: make([]Result, 0, len(verifications))}
+
+	for _, verification := range verifications {
+		start := time.Now()
+		err := runVerification(ctx, verification)
+		report.Results = append(report.Results, Result{
+			Name:     verification.Name,
+			Err:      err,
+			Duration: time.Since(start),
+		})
+	}
+
+	return report
+}
+
+// runVerification runs a single verification, converting a panic into an error
+func runVerification(ctx context.Context, verification Verification) (err error) {
+	if verification.Check == nil {
+		return fmt.Errorf("verification has no check")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("verification panicked: %v", r)
+		}
+	}()
+
+	return verification.Check(ctx)
+}
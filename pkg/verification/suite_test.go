@@ -0,0 +1,72 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAll(t *testing.T) {
+	errBroken := errors.New("broken")
+	var ran []string
+	check := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	report := RunAll(context.Background(), []Verification{
+		{Name: "health", Check: check("health", nil)},
+		{Name: "metrics", Check: check("metrics", errBroken)},
+		{Name: "panics", Check: func(context.Context) error {
+			ran = append(ran, "panics")
+			panic("nil map")
+		}},
+		{Name: "no check"},
+		{Name: "components", Check: check("components", nil)},
+	})
+
+	require.Equal(t, []string{"health", "metrics", "panics", "components"}, ran)
+	require.False(t, report.Passed())
+
+	tests := []struct {
+		name    string
+		wantErr string
+	}{
+		{"health", ""},
+		{"metrics", "broken"},
+		{"panics", "verification panicked: nil map"},
+		{"no check", "verification has no check"},
+		{"components", ""},
+	}
+	require.Len(t, report.Results, len(tests))
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := report.Results[i]
+			require.Equal(t, tt.name, result.Name)
+			if tt.wantErr == "" {
+				require.True(t, result.Passed())
+				return
+			}
+			require.EqualError(t, result.Err, tt.wantErr)
+		})
+	}
+
+	require.Len(t, report.Failures(), 3)
+	err := report.Err()
+	require.ErrorIs(t, err, errBroken)
+	require.EqualError(t, err, "metrics: broken\npanics: verification panicked: nil map\nno check: verification has no check")
+}
+
+func TestRunAllPassed(t *testing.T) {
+	report := RunAll(context.Background(), []Verification{
+		{Name: "health", Check: func(context.Context) error { return nil }},
+	})
+
+	require.True(t, report.Passed())
+	require.NoError(t, report.Err())
+	require.Empty(t, report.Failures())
+}
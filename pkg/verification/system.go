@@ -4,25 +4,48 @@ package verification
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	domaincontainer "github.com/fintechain/skeleton-testkit/internal/domain/container"
 	"github.com/fintechain/skeleton-testkit/pkg/container"
 )
 
 // SystemVerifier verifies skeleton application system-level behavior
 type SystemVerifier struct {
-	app *container.AppContainer
+	app              *container.AppContainer
+	shutdownMethod   string
+	shutdownDeadline time.Duration
 }
 
 // NewSystemVerifier creates a new SystemVerifier for the given application container
 func NewSystemVerifier(app *container.AppContainer) *SystemVerifier {
 	return &SystemVerifier{
-		app: app,
+		app:              app,
+		shutdownMethod:   http.MethodPost,
+		shutdownDeadline: 30 * time.Second,
 	}
 }
 
+// WithShutdownMethod sets the HTTP method used to call the shutdown endpoint (POST by default)
+func (s *SystemVerifier) WithShutdownMethod(method string) *SystemVerifier {
+	s.shutdownMethod = method
+	return s
+}
+
+// WithShutdownDeadline sets how long the application may take to terminate
+// after its shutdown endpoint responds (30 seconds by default)
+func (s *SystemVerifier) WithShutdownDeadline(deadline time.Duration) *SystemVerifier {
+	s.shutdownDeadline = deadline
+	return s
+}
+
 // VerifySkeletonStartup verifies that the skeleton application starts successfully
 // and all skeleton components are properly initialized
 func (s *SystemVerifier) VerifySkeletonStartup(ctx context.Context) error {
@@ -62,6 +85,63 @@ func (s *SystemVerifier) VerifySkeletonShutdown(ctx context.Context) error {
 	return nil
 }
 
+// VerifyShutdownEndpointResponds verifies that the shutdown endpoint accepts the
+// request with status 200 or 202 and that the application then terminates
+// within the shutdown deadline. Since the process may exit while responding,
+// a connection closed cleanly after the status line, or before any response,
+// is accepted as long as the application terminates.
+func (s *SystemVerifier) VerifyShutdownEndpointResponds(ctx context.Context) error {
+	if !s.app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	baseURL := s.app.ConnectionString()
+	if baseURL == "" {
+		return fmt.Errorf("unable to get application connection string")
+	}
+
+	shutdownEndpoint := s.app.ShutdownEndpoint()
+	if shutdownEndpoint == "" {
+		return fmt.Errorf("shutdown endpoint not configured")
+	}
+
+	client := newHTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, s.shutdownMethod, baseURL+shutdownEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to reach shutdown endpoint: %w", err)
+		}
+	} else {
+		// The body may be cut short by the exiting process
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+			return fmt.Errorf("shutdown endpoint returned status %d", resp.StatusCode)
+		}
+	}
+
+	deadline := time.Now().Add(s.shutdownDeadline)
+	for s.app.IsRunning() {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("skeleton application still running %v after shutdown was requested", s.shutdownDeadline)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for skeleton application to terminate: %w", ctx.Err())
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+
+	return nil
+}
+
 // VerifySkeletonHealth verifies the health status of the skeleton application
 func (s *SystemVerifier) VerifySkeletonHealth(ctx context.Context) error {
 	if !s.app.IsRunning() {
@@ -92,10 +172,15 @@ func (s *SystemVerifier) verifySkeletonSystemService(ctx context.Context) error
 		return fmt.Errorf("unable to get application connection string")
 	}
 
+	systemHealthPath := s.app.SkeletonContract().SystemHealthPath
+	if systemHealthPath == "" {
+		return fmt.Errorf("system health endpoint not configured")
+	}
+
 	// Check skeleton system service endpoint
-	systemURL := baseURL + "/api/system/health"
+	systemURL := baseURL + systemHealthPath
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := newHTTPClient(ctx)
 	req, err := http.NewRequestWithContext(ctx, "GET", systemURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
@@ -121,9 +206,14 @@ func (s *SystemVerifier) verifyHealthEndpoint(ctx context.Context) error {
 		return fmt.Errorf("unable to get application connection string")
 	}
 
-	healthURL := baseURL + s.app.HealthEndpoint()
+	healthEndpoint := s.app.HealthEndpoint()
+	if healthEndpoint == "" {
+		return fmt.Errorf("health endpoint not configured")
+	}
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	healthURL := baseURL + healthEndpoint
+
+	client := newHTTPClient(ctx)
 	req, err := http.NewRequestWithContext(ctx, "GET", healthURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
@@ -141,3 +231,135 @@ func (s *SystemVerifier) verifyHealthEndpoint(ctx context.Context) error {
 
 	return nil
 }
+
+// VerifyRestartConsistency verifies that restarting the skeleton application
+// yields the same registered component set and a healthy status every time,
+// catching non-idempotent initialization. The application is restarted the
+// given number of times and every drift from the initial state is reported.
+func VerifyRestartConsistency(ctx context.Context, app *container.AppContainer, restarts int) error {
+	if !app.IsRunning() {
+		return fmt.Errorf("skeleton application is not running")
+	}
+
+	system := NewSystemVerifier(app)
+	components := NewComponentVerifier(app)
+
+	if err := system.VerifySkeletonHealth(ctx); err != nil {
+		return fmt.Errorf("skeleton application is not healthy before restarting: %w", err)
+	}
+
+	baseline, err := components.getRegisteredComponents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get registered components before restarting: %w", err)
+	}
+
+	drift := make([]string, 0)
+	for cycle := 1; cycle <= restarts; cycle++ {
+		if err := app.Restart(ctx); err != nil {
+			return fmt.Errorf("restart %d failed: %w", cycle, err)
+		}
+
+		if err := system.VerifySkeletonHealth(ctx); err != nil {
+			drift = append(drift, fmt.Sprintf("restart %d: %v", cycle, err))
+		}
+
+		current, err := components.getRegisteredComponents(ctx)
+		if err != nil {
+			drift = append(drift, fmt.Sprintf("restart %d: failed to get registered components: %v", cycle, err))
+			continue
+		}
+
+		missing, unexpected := diffComponents(baseline, current)
+		if len(missing) > 0 || len(unexpected) > 0 {
+			drift = append(drift, fmt.Sprintf("restart %d: component set changed (missing: %v, unexpected: %v)",
+				cycle, missing, unexpected))
+		}
+	}
+
+	if len(drift) > 0 {
+		return fmt.Errorf("skeleton application is not consistent across restarts: %s", strings.Join(drift, "; "))
+	}
+
+	return nil
+}
+
+// VerifyStartupOrderIndependence verifies that the skeleton application comes up
+// healthy regardless of the order in which its dependencies are started. For
+// each of the given number of random permutations of the dependency list, the
+// environment is started, checked for health and terminated; every failing
+// order is reported. The application must not be running beforehand and is
+// terminated afterwards, with its original dependency order restored.
+func VerifyStartupOrderIndependence(ctx context.Context, app *container.AppContainer, permutations int) error {
+	if app.IsRunning() {
+		return fmt.Errorf("skeleton application must not be running")
+	}
+
+	original := app.Dependencies()
+	order := make([]domaincontainer.Container, len(original))
+	copy(order, original)
+	defer func() { _ = app.ReorderDependencies(original) }()
+
+	system := NewSystemVerifier(app)
+	failures := make([]string, 0)
+
+	for run := 1; run <= permutations; run++ {
+		rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		if err := app.ReorderDependencies(order); err != nil {
+			return err
+		}
+		orderDesc := dependencyOrder(order)
+
+		if err := app.Start(ctx); err != nil {
+			failures = append(failures, fmt.Sprintf("order %s: start failed: %v", orderDesc, err))
+		} else if err := system.VerifySkeletonHealth(ctx); err != nil {
+			failures = append(failures, fmt.Sprintf("order %s: %v", orderDesc, err))
+		}
+
+		if err := app.Terminate(ctx); err != nil {
+			return fmt.Errorf("failed to tear down after order %s: %w", orderDesc, err)
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("skeleton application depends on dependency startup order: %s", strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// VerifyStopOrder verifies that the last Stop of the skeleton application
+// stopped its dependencies in the expected order, given as container names,
+// and that every stop succeeded. Dependencies that were not running when
+// Stop was called are not recorded and must not be listed.
+func VerifyStopOrder(app *container.AppContainer, expected []string) error {
+	records := app.StopOrder()
+
+	actual := make([]string, len(records))
+	failures := make([]string, 0)
+	for i, record := range records {
+		actual[i] = record.Name
+		if record.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", record.Name, record.Err))
+		}
+	}
+
+	if !slices.Equal(actual, expected) {
+		return fmt.Errorf("dependencies stopped in order [%s], expected [%s]",
+			strings.Join(actual, ", "), strings.Join(expected, ", "))
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("dependencies failed to stop: %s", strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// dependencyOrder describes a dependency order by container name
+func dependencyOrder(deps []domaincontainer.Container) string {
+	names := make([]string, len(deps))
+	for i, dep := range deps {
+		names[i] = dep.Name()
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
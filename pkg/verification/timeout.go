@@ -0,0 +1,47 @@
+package verification
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// DefaultRequestTimeout bounds each HTTP request made by the verifiers when
+// the caller's context has no deadline, unless overridden with WithRequestTimeout
+const DefaultRequestTimeout = 10 * time.Second
+
+// requestTimeoutKey is the context key of the request timeout override
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context overriding the timeout of each HTTP
+// request the verifiers make with it, e.g. to give a slow metadata endpoint
+// more time or to fail a liveness check fast, without a new verifier
+//
+// Example:
+//
+//	err := verifier.VerifySkeletonComponentMetadata(
+//	    verification.WithRequestTimeout(ctx, 30*time.Second), "storage", expected)
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// requestTimeout returns the timeout of a verifier request made with ctx
+func requestTimeout(ctx context.Context) time.Duration {
+	if timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok && timeout > 0 {
+		return timeout
+	}
+	return DefaultRequestTimeout
+}
+
+// newHTTPClient returns the HTTP client for a verifier request made with ctx.
+// A deadline of ctx bounds the request on its own, whether shorter or longer
+// than the default; the client timeout then only applies if set explicitly
+// with WithRequestTimeout.
+func newHTTPClient(ctx context.Context) *http.Client {
+	if _, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); !ok {
+		if _, hasDeadline := ctx.Deadline(); hasDeadline {
+			return &http.Client{}
+		}
+	}
+	return &http.Client{Timeout: requestTimeout(ctx)}
+}
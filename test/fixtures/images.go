@@ -1,6 +1,13 @@
 // Package fixtures provides test fixtures and constants for skeleton-testkit integration tests.
 package fixtures
 
+import "github.com/fintechain/skeleton-testkit/internal/domain/container"
+
+// SkeletonContract describes the HTTP port and endpoint paths a skeleton
+// application image exposes. Use it with AppContainer.WithSkeletonContract
+// for images that don't follow the default contract.
+type SkeletonContract = container.SkeletonContract
+
 const (
 	// TestSkeletonAppImage is a minimal skeleton application image for testing
 	// This image implements the required skeleton endpoints:
@@ -29,3 +36,8 @@ func GetDefaultTestImage() string {
 func GetTestImageWithTag(tag string) string {
 	return "fintechain/test-skeleton-app:" + tag
 }
+
+// DefaultSkeletonContract returns the contract implemented by the test images
+func DefaultSkeletonContract() SkeletonContract {
+	return container.DefaultSkeletonContract()
+}